@@ -0,0 +1,62 @@
+// Package testutil paketlerin testlerinde ortak kullanılan yardımcıları içerir.
+// Yalnızca _test.go dosyalarından içe aktarılmalıdır.
+package testutil
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Main testleri Türkçe mesajlarla çalıştırır; testler Türkçe mesajları
+// doğruladığından sonuç ortamın LANG değerine bağlı olmasın. Paketlerin
+// TestMain fonksiyonundan çağrılır.
+func Main(m *testing.M) {
+	i18n.SetLanguage(i18n.Turkish)
+	os.Exit(m.Run())
+}
+
+// NewSession verilen nesnelerle dolu fake client kullanan bir oturum
+// döndürür. Sıfır değerli ayarlar bekleme ve zaman aşımını kapatır.
+func NewSession(objects ...runtime.Object) *session.Session {
+	return &session.Session{Name: "test", Client: fake.NewClientset(objects...)}
+}
+
+// RunWithInput fn'i verilen girdiyle çalıştırır ve standart çıktıya yazılanları döndürür
+func RunWithInput(t *testing.T, input string, fn func()) string {
+	t.Helper()
+
+	stdinReader, stdinWriter, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldStdin, oldStdout := os.Stdin, os.Stdout
+	os.Stdin, os.Stdout = stdinReader, stdoutWriter
+	defer func() { os.Stdin, os.Stdout = oldStdin, oldStdout }()
+
+	go func() {
+		io.WriteString(stdinWriter, input)
+		stdinWriter.Close()
+	}()
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(stdoutReader)
+		output <- string(data)
+	}()
+
+	fn()
+	stdoutWriter.Close()
+	stdinReader.Close()
+	return <-output
+}
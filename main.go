@@ -1,15 +1,85 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"tamerGoClient/pkg/auth"
+	"tamerGoClient/pkg/cli"
+	"tamerGoClient/pkg/config"
+	"tamerGoClient/pkg/i18n"
 	"tamerGoClient/pkg/info"
 	"tamerGoClient/pkg/resource"
+	"tamerGoClient/pkg/tui"
 	"tamerGoClient/pkg/ui"
 )
 
 func main() {
+	overrides := config.OverrideFlag{}
+	envFile := flag.String("env-file", config.DefaultEnvFilePath(), i18n.N("Proje .env dosyasının yolu; profiller ve kasa bu dosyanın yanında tutulur"))
+	userConfig := flag.String("config", config.DefaultUserConfigPath(), i18n.N("Kullanıcı config dosyasının yolu"))
+	apiServer := flag.String("api-server", "", i18n.N("API_SERVER değerini ezer"))
+	kubeconfig := flag.String("kubeconfig", "", i18n.N("KUBECONFIG_PATH değerini ezer"))
+	caCert := flag.String("ca-cert", "", i18n.N("CA_CERT_PATH değerini ezer"))
+	showConfig := flag.Bool("show-config", false, i18n.N("Etkin yapılandırmayı ve kaynaklarını gösterip çık"))
+	noRestore := flag.Bool("no-restore", false, i18n.N("Açılışta son bağlantıyı otomatik geri yükleme"))
+	doctor := flag.Bool("doctor", false, i18n.N("Bağlantı tanılamasını çalıştırıp çık (hata varsa çıkış kodu 1)"))
+	flag.Var(overrides, "set", i18n.N("Herhangi bir anahtarı ezer (KEY=VALUE, tekrar verilebilir)"))
+	flag.Usage = func() {
+		// Yardım flag.Parse sırasında, Init'ten önce yazılır; UI_LANGUAGE bu
+		// yüzden burada okunur ve bayrak açıklamaları gösterilirken çevrilir
+		opts := config.Options{EnvFile: *envFile, UserConfig: *userConfig, Overrides: overrides}
+		i18n.SetLanguage(i18n.Detect(opts.Language()))
+		flag.VisitAll(func(f *flag.Flag) {
+			f.Usage = i18n.T(f.Usage)
+		})
+		cli.PrintUsage(flag.CommandLine.Output())
+		fmt.Fprintln(flag.CommandLine.Output(), i18n.T("\nSeçenekler:"))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	for key, value := range map[string]string{
+		"API_SERVER":      *apiServer,
+		"KUBECONFIG_PATH": *kubeconfig,
+		"CA_CERT_PATH":    *caCert,
+	} {
+		if value != "" {
+			overrides[key] = value
+		}
+	}
+
+	auth.Init(config.Options{
+		EnvFile:    *envFile,
+		UserConfig: *userConfig,
+		Overrides:  overrides,
+	})
+
+	if *showConfig {
+		auth.ShowEffectiveConfig()
+		return
+	}
+
+	if *doctor {
+		if !auth.RunDiagnostics() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Komut verilmişse menüsüz çalışılır
+	if flag.NArg() > 0 {
+		os.Exit(cli.New(auth.ConnectNonInteractive).Run(flag.Args()))
+	}
+
+	// Son bağlantı geri yüklenemezse doğrudan kimlik doğrulama menüsüyle başla
+	if !*noRestore {
+		if _, err := auth.RestoreLastConnection(); err != nil {
+			i18n.Fprintf(os.Stderr, ">>> Otomatik bağlantı başarısız: %v\n", err)
+			auth.HandleAuthMenu()
+		}
+	}
+
 	for {
 		choice := ui.ShowMainMenu()
 
@@ -17,14 +87,18 @@ func main() {
 		case 1:
 			auth.HandleAuthMenu()
 		case 2:
-			info.HandleInfoMenu()
+			info.HandleInfoMenu(auth.Current())
 		case 3:
-			resource.HandleResourceMenu()
+			resource.HandleResourceMenu(auth.Current())
 		case 4:
-			fmt.Println("Programdan çıkılıyor...")
+			if err := tui.Run(auth.Current()); err != nil {
+				i18n.Printf("Hata: %v\n", err)
+			}
+		case 5:
+			fmt.Println(i18n.T("Programdan çıkılıyor..."))
 			os.Exit(0)
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
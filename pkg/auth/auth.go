@@ -1,32 +1,57 @@
 package auth
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"tamerGoClient/pkg/config"
+	"tamerGoClient/pkg/i18n"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/util/homedir"
 )
 
 var (
-	KubeClient       *kubernetes.Clientset
-	envManager       *config.EnvManager
-	activeConnection string // Aktif bağlantı bilgisini tutacak
+	envManager       *config.EnvManager // Katmanlı yapılandırma, .env yazılabilir katmandır
+	activeConnection string             // Aktif bağlantı bilgisini tutacak
 )
 
-func init() {
-	envManager = config.NewEnvManager(".env")
+// Init yapılandırma katmanlarını (komut satırı, ortam, .env, kullanıcı
+// config) ve profil dosyasını yükler. Menüler kullanılmadan önce çağrılmalıdır.
+// Uyarılar komut satırı çıktısını bozmamak için stderr'e yazılır.
+func Init(opts config.Options) {
+	envManager = config.NewEnvManager(opts.EnvFile)
 	if err := envManager.Load(); err != nil {
-		fmt.Printf("Uyarı: .env dosyası yüklenirken hata oluştu: %v\n", err)
+		i18n.Fprintf(os.Stderr, "Uyarı: .env dosyası yüklenirken hata oluştu: %v\n", err)
+	}
+	if err := envManager.AttachUserConfig(opts.UserConfig); err != nil {
+		i18n.Fprintf(os.Stderr, "Uyarı: Kullanıcı config dosyası yüklenirken hata oluştu: %v\n", err)
+	}
+	envManager.SetOverrides(opts.Overrides)
+	// UI_LANGUAGE (tr/en) tanımlı değilse dil LC_ALL/LANG ortam değişkenlerinden belirlenir
+	i18n.SetLanguage(i18n.Detect(envManager.Get("UI_LANGUAGE")))
+	if err := envManager.AttachVault(config.NewVault(opts.EnvFile + ".vault")); err != nil {
+		i18n.Fprintf(os.Stderr, "Uyarı: Şifreli kasa açılamadı, gizli değerler kullanılamayacak: %v\n", err)
+	}
+
+	// Profiller ve son bağlantı çalışma dizinine değil .env dosyasının
+	// bulunduğu dizine göre çözülür
+	dir := filepath.Dir(opts.EnvFile)
+	lastConnectionFile = filepath.Join(dir, ".last-connection.json")
+
+	profileStore = NewProfileStore(filepath.Join(dir, profilesFileName), envManager)
+	if err := profileStore.Load(); err != nil {
+		i18n.Fprintf(os.Stderr, "Uyarı: Profil dosyası yüklenirken hata oluştu: %v\n", err)
+	} else if profileStore.inlineSecrets {
+		// Eski sürümlerin düz metin yazdığı token'lar .env'e veya kasaya taşınır
+		if err := profileStore.Save(); err != nil {
+			i18n.Fprintf(os.Stderr, "Uyarı: Profil dosyasındaki gizli değerler taşınamadı: %v\n", err)
+		}
 	}
 }
 
@@ -38,15 +63,23 @@ type ServiceAccountConfig struct {
 }
 
 func showAuthMenu() int {
-	fmt.Println("\n=== Kimlik Doğrulama Yöntemleri ===")
+	fmt.Println(i18n.T("\n=== Kimlik Doğrulama Yöntemleri ==="))
 	if activeConnection != "" {
-		fmt.Printf("(Aktif Bağlantı: via %s)\n", activeConnection)
+		i18n.Printf("(Aktif Bağlantı: via %s)\n", activeConnection)
 	}
-	fmt.Println("1. Service Account ile Bağlan")
-	fmt.Println("2. In-Cluster Bağlantı")
-	fmt.Println("3. Kubeconfig ile Bağlan")
-	fmt.Println("4. Ana Menüye Dön")
-	fmt.Print("Seçiminiz (1-4): ")
+	fmt.Println(i18n.T("1. Service Account ile Bağlan"))
+	fmt.Println(i18n.T("2. In-Cluster Bağlantı"))
+	fmt.Println(i18n.T("3. Kubeconfig ile Bağlan"))
+	fmt.Println(i18n.T("4. Client Sertifikası (mTLS) ile Bağlan"))
+	fmt.Println(i18n.T("5. Bağlantı Profilleri"))
+	fmt.Println(i18n.T("6. Yetkilerimi İncele"))
+	fmt.Println(i18n.T("7. Aktif Bağlantıyı Kubeconfig Olarak Dışa Aktar"))
+	fmt.Println(i18n.T("8. Kimliğe Bürünme (Impersonation)"))
+	fmt.Println(i18n.T("9. Bağlantı Tanılama"))
+	fmt.Println(i18n.T("10. Sertifika İnceleme ve Pinleme"))
+	fmt.Println(i18n.T("11. Varsayılan Namespace Seç"))
+	fmt.Println(i18n.T("12. Ana Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-12): "))
 
 	var choice int
 	fmt.Scanf("%d", &choice)
@@ -54,19 +87,24 @@ func showAuthMenu() int {
 }
 
 func showServiceAccountMenu() int {
-	fmt.Println("\n=== Service Account Bağlantı Menüsü ===")
-	if activeConnection != "" && strings.HasPrefix(activeConnection, "ServiceAccount") {
-		fmt.Printf("(Aktif Bağlantı: via %s)\n", activeConnection)
-	}
-	fmt.Println("\nGerekli .env değerleri:")
-	fmt.Println("- API_SERVER: Kubernetes API sunucu adresi")
-	fmt.Println("- K8S_TOKEN: Service Account token değeri")
-	fmt.Println("- CA_CERT_PATH: CA sertifika dosyasının yolu")
-	fmt.Println("\nSeçenekler:")
-	fmt.Println("1. Bağlantıyı Yapılandır")
-	fmt.Println("2. .env Dosyasını Düzenle")
-	fmt.Println("3. Önceki Menüye Dön")
-	fmt.Print("Seçiminiz (1-3): ")
+	fmt.Println(i18n.T("\n=== Service Account Bağlantı Menüsü ==="))
+	if activeSpec.Method == MethodServiceAccount || activeSpec.Method == MethodExec || activeSpec.Method == MethodOIDC {
+		i18n.Printf("(Aktif Bağlantı: via %s)\n", activeConnection)
+	}
+	fmt.Println(i18n.T("\nGerekli .env değerleri:"))
+	fmt.Println(i18n.T("- API_SERVER: Kubernetes API sunucu adresi"))
+	fmt.Println(i18n.T("- K8S_TOKEN: Service Account token değeri"))
+	fmt.Println(i18n.T("  (veya K8S_TOKEN_FILE: dönen/projected token dosyasının yolu)"))
+	fmt.Println(i18n.T("- CA_CERT_PATH: CA sertifika dosyasının yolu"))
+	fmt.Println(i18n.T("\nExec plugin için: EXEC_COMMAND, EXEC_ARGS (tırnaklı veya JSON dizi), EXEC_ENV (KEY=VAL,...), EXEC_API_VERSION"))
+	fmt.Println(i18n.T("OIDC için: OIDC_ISSUER_URL, OIDC_CLIENT_ID, OIDC_CLIENT_SECRET, OIDC_REFRESH_TOKEN"))
+	fmt.Println(i18n.T("\nSeçenekler:"))
+	fmt.Println(i18n.T("1. Bağlantıyı Yapılandır (Statik Token)"))
+	fmt.Println(i18n.T("2. Exec Plugin ile Bağlan"))
+	fmt.Println(i18n.T("3. OIDC ile Bağlan"))
+	fmt.Println(i18n.T("4. .env Dosyasını Düzenle"))
+	fmt.Println(i18n.T("5. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-5): "))
 
 	var choice int
 	fmt.Scanf("%d", &choice)
@@ -74,17 +112,19 @@ func showServiceAccountMenu() int {
 }
 
 func showKubeconfigMenu() int {
-	fmt.Println("\n=== Kubeconfig Bağlantı Menüsü ===")
+	fmt.Println(i18n.T("\n=== Kubeconfig Bağlantı Menüsü ==="))
 	if activeConnection != "" && strings.HasPrefix(activeConnection, "Kubeconfig") {
-		fmt.Printf("(Aktif Bağlantı: %s)\n", activeConnection)
+		i18n.Printf("(Aktif Bağlantı: %s)\n", activeConnection)
 	}
-	fmt.Println("\nGerekli .env değeri:")
-	fmt.Println("- KUBECONFIG_PATH: Kubeconfig dosyasının yolu")
-	fmt.Println("\nSeçenekler:")
-	fmt.Println("1. Bağlantıyı Yapılandır")
-	fmt.Println("2. .env Dosyasını Düzenle")
-	fmt.Println("3. Önceki Menüye Dön")
-	fmt.Print("Seçiminiz (1-3): ")
+	fmt.Println(i18n.T("\nGerekli .env değeri:"))
+	fmt.Println(i18n.T("- KUBECONFIG_PATH: Kubeconfig dosyasının yolu"))
+	fmt.Println(i18n.T("\nSeçenekler:"))
+	fmt.Println(i18n.T("1. Bağlantıyı Yapılandır"))
+	fmt.Println(i18n.T("2. Context Değiştir"))
+	fmt.Println(i18n.T("3. Service Account Erişimi Kur (Yönetici)"))
+	fmt.Println(i18n.T("4. .env Dosyasını Düzenle"))
+	fmt.Println(i18n.T("5. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-5): "))
 
 	var choice int
 	fmt.Scanf("%d", &choice)
@@ -92,7 +132,7 @@ func showKubeconfigMenu() int {
 }
 
 func waitForMainMenu() {
-	fmt.Print("\nAna menüye dönmek için herhangi bir tuşa basın: ")
+	fmt.Print(i18n.T("\nAna menüye dönmek için herhangi bir tuşa basın: "))
 	var input string
 	fmt.Scanf("%s", &input)
 }
@@ -106,11 +146,17 @@ func handleServiceAccountMenu() {
 			connectWithServiceAccount()
 			return // Bağlantı başarılı olduğunda direkt ana menüye dön
 		case 2:
-			envManager.ShowEnvMenu()
+			connectWithExecPlugin()
+			return
 		case 3:
+			connectWithOIDC()
+			return
+		case 4:
+			envManager.ShowEnvMenu()
+		case 5:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
@@ -124,11 +170,16 @@ func handleKubeconfigMenu() {
 			connectWithKubeconfig()
 			return // Bağlantı başarılı olduğunda direkt ana menüye dön
 		case 2:
-			envManager.ShowEnvMenu()
+			switchKubeconfigContext()
+			return
 		case 3:
+			bootstrapServiceAccount()
+		case 4:
+			envManager.ShowEnvMenu()
+		case 5:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
@@ -140,127 +191,197 @@ func HandleAuthMenu() {
 		switch choice {
 		case 1:
 			handleServiceAccountMenu()
-			if KubeClient != nil {
+			if activeSession != nil {
 				return // Bağlantı başarılı olduğunda ana menüye dön
 			}
 		case 2:
 			connectInCluster()
-			if KubeClient != nil {
+			if activeSession != nil {
 				return // Bağlantı başarılı olduğunda ana menüye dön
 			}
 		case 3:
 			handleKubeconfigMenu()
-			if KubeClient != nil {
+			if activeSession != nil {
 				return // Bağlantı başarılı olduğunda ana menüye dön
 			}
 		case 4:
+			handleClientCertMenu()
+			if activeSession != nil {
+				return // Bağlantı başarılı olduğunda ana menüye dön
+			}
+		case 5:
+			handleProfileMenu()
+			if activeSession != nil {
+				return // Bağlantı başarılı olduğunda ana menüye dön
+			}
+		case 6:
+			handlePermissionMenu()
+		case 7:
+			exportKubeconfig()
+		case 8:
+			handleImpersonationMenu()
+		case 9:
+			RunDiagnostics()
+		case 10:
+			handleCertificateMenu()
+		case 11:
+			handleNamespaceMenu()
+		case 12:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
 func connectWithServiceAccount() {
 	// .env'den değerleri oku
-	serverURL := envManager.Get("API_SERVER")
-	token := envManager.Get("K8S_TOKEN")
-	caPath := envManager.Get("CA_CERT_PATH")
+	p := profileFromEnv(MethodServiceAccount)
 
 	// Değerler eksikse kullanıcıdan al
-	if serverURL == "" {
+	if p.ServerURL == "" {
 		fmt.Print("Kubernetes API Server URL: ")
-		fmt.Scanf("%s", &serverURL)
-		envManager.Set("API_SERVER", serverURL)
+		fmt.Scanf("%s", &p.ServerURL)
+		envManager.Set("API_SERVER", p.ServerURL)
 	}
 
-	if token == "" {
+	// Dönen (projected) token dosyası tanımlıysa statik token'a gerek yok
+	if p.Token == "" && p.TokenFile == "" {
 		fmt.Print("Service Account Token: ")
-		fmt.Scanf("%s", &token)
-		envManager.Set("K8S_TOKEN", token)
+		fmt.Scanf("%s", &p.Token)
+		setSecret("K8S_TOKEN", p.Token)
 	}
 
-	if caPath == "" {
-		fmt.Print("CA Sertifika dosya yolu: ")
-		fmt.Scanf("%s", &caPath)
-		envManager.Set("CA_CERT_PATH", caPath)
+	if p.CACertPath == "" {
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+		envManager.Set("CA_CERT_PATH", p.CACertPath)
 	}
 
 	// Değişiklikleri kaydet
 	if err := envManager.Save(); err != nil {
-		fmt.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
+		i18n.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
 	}
 
-	// CA sertifikasını oku
-	caData, err := os.ReadFile(caPath)
-	if err != nil {
-		fmt.Printf("CA sertifikası okunamadı: %v\n", err)
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("Service Account ile bağlantı başarısız: %v\n", err)
+		fmt.Println(i18n.T("Ayrıntılı kontrol için Kimlik Doğrulama menüsünden 'Bağlantı Tanılama'yı çalıştırın."))
 		return
 	}
 
-	// Rest config oluştur
-	config := &rest.Config{
-		Host:        serverURL,
-		BearerToken: token,
-		Timeout:     30 * time.Second,
-		TLSClientConfig: rest.TLSClientConfig{
-			CAData:   caData,
-			Insecure: false,
-		},
+	fmt.Println(i18n.T("Service Account ile bağlantı başarılı!"))
+	warnTokenExpiry()
+	waitForMainMenu()
+}
+
+// setSecret kullanıcıdan alınan gizli değeri kaydeder. Kasa kilitliyse değer
+// diske yazılmaz, yalnızca bu bağlantıda kullanılır.
+func setSecret(key, value string) {
+	if err := envManager.Set(key, value); err != nil {
+		i18n.Printf("Uyarı: %s yalnızca bu oturumda kullanılacak: %v\n", key, err)
 	}
+}
 
-	// Client oluştur
-	client, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		fmt.Printf("Client oluşturulamadı: %v\n", err)
+func connectInCluster() {
+	fmt.Println(i18n.T("\n=== In-Cluster Bağlantı ==="))
+	if err := connectProfile(Profile{Method: MethodInCluster}); err != nil {
+		i18n.Printf("In-cluster bağlantı başarısız: %v\n", err)
 		return
 	}
 
-	// Bağlantıyı test et
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	fmt.Println(i18n.T("In-cluster bağlantı başarılı!"))
+	waitForMainMenu()
+}
 
-	_, err = client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+func connectWithKubeconfig() {
+	kubeconfigPath := resolveKubeconfigPath()
+
+	kubeconfig, err := loadKubeconfig(kubeconfigPath)
 	if err != nil {
-		fmt.Printf("Bağlantı testi başarısız: %v\n", err)
+		i18n.Printf("Hata: %v\n", err)
 		return
 	}
 
-	KubeClient = client
-	serverURL = envManager.Get("API_SERVER")
-	activeConnection = fmt.Sprintf("ServiceAccount (%s)", serverURL)
-	fmt.Println("Service Account ile bağlantı başarılı!")
-	waitForMainMenu()
+	contextName, err := selectKubeconfigContext(kubeconfig)
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	p := Profile{
+		Method:         MethodKubeconfig,
+		KubeconfigPath: kubeconfigPath,
+		Context:        contextName,
+	}
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("Kubeconfig ile bağlantı başarısız: %v\n", err)
+		return
+	}
 
+	i18n.Printf("Kubeconfig ile bağlantı başarılı! (%s, context: %s)\n", kubeconfigPath, contextName)
+	waitForMainMenu()
 }
 
-func connectInCluster() {
-	fmt.Println("\n=== In-Cluster Bağlantı ===")
-	config, err := rest.InClusterConfig()
+// switchKubeconfigContext aktif kubeconfig dosyasındaki başka bir context'e
+// programı yeniden başlatmadan geçiş yapar
+func switchKubeconfigContext() {
+	if activeSpec.Method != MethodKubeconfig {
+		fmt.Println(i18n.T("\nUyarı: Context değiştirmek için önce kubeconfig ile bağlanmalısınız!"))
+		return
+	}
+
+	kubeconfig, err := loadKubeconfig(activeSpec.KubeconfigPath)
 	if err != nil {
-		fmt.Printf("In-cluster config oluşturulamadı: %v\n", err)
+		i18n.Printf("Hata: %v\n", err)
 		return
 	}
 
-	client, err := kubernetes.NewForConfig(config)
+	contextName, err := selectKubeconfigContext(kubeconfig)
 	if err != nil {
-		fmt.Printf("Client oluşturulamadı: %v\n", err)
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	if contextName == activeSpec.Context {
+		i18n.Printf("Zaten '%s' context'i kullanılıyor.\n", contextName)
 		return
 	}
 
-	KubeClient = client
-	activeConnection = "In-Cluster"
-	fmt.Println("In-cluster bağlantı başarılı!")
+	// Context değişince bağlantı artık kayıtlı profile ait sayılmaz
+	p := Profile{
+		Method:         MethodKubeconfig,
+		KubeconfigPath: activeSpec.KubeconfigPath,
+		Context:        contextName,
+	}
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("Context değiştirilemedi: %v\n", err)
+		return
+	}
+
+	i18n.Printf("Context değiştirildi: %s\n", contextName)
 	waitForMainMenu()
 }
 
-func connectWithKubeconfig() {
-	// .env'den kubeconfig yolunu oku
+// loadKubeconfig dosyanın varlığını kontrol edip kubeconfig'i yükler
+func loadKubeconfig(kubeconfigPath string) (*clientcmdapi.Config, error) {
+	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
+		return nil, i18n.Errorf("%s dosyası bulunamadı", kubeconfigPath)
+	}
+
+	kubeconfig, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return nil, i18n.Errorf("kubeconfig yüklenemedi: %v", err)
+	}
+	return kubeconfig, nil
+}
+
+// resolveKubeconfigPath kubeconfig yolunu .env'den okur, yoksa kullanıcıdan alır
+func resolveKubeconfigPath() string {
 	kubeconfigPath := envManager.Get("KUBECONFIG_PATH")
 	defaultPath := filepath.Join(homedir.HomeDir(), ".kube", "config")
 
 	if kubeconfigPath == "" {
-		fmt.Printf("Kubeconfig dosya yolu [varsayılan: %s]: ", defaultPath)
+		i18n.Printf("Kubeconfig dosya yolu [varsayılan: %s]: ", defaultPath)
 		fmt.Scanf("%s", &kubeconfigPath)
 
 		if kubeconfigPath == "" {
@@ -269,50 +390,66 @@ func connectWithKubeconfig() {
 
 		envManager.Set("KUBECONFIG_PATH", kubeconfigPath)
 		if err := envManager.Save(); err != nil {
-			fmt.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
+			i18n.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
 		}
 	}
+	return kubeconfigPath
+}
 
-	// Dosyanın varlığını kontrol et
-	if _, err := os.Stat(kubeconfigPath); os.IsNotExist(err) {
-		fmt.Printf("Hata: %s dosyası bulunamadı\n", kubeconfigPath)
-		return
+// selectKubeconfigContext kubeconfig içindeki context'leri listeler ve
+// kullanıcının birini seçmesini sağlar. 0 girilirse current-context kullanılır.
+func selectKubeconfigContext(kubeconfig *clientcmdapi.Config) (string, error) {
+	if len(kubeconfig.Contexts) == 0 {
+		return "", i18n.Errorf("kubeconfig dosyasında hiç context bulunamadı")
 	}
 
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
-	if err != nil {
-		fmt.Printf("Kubeconfig yüklenemedi: %v\n", err)
-		return
+	names := make([]string, 0, len(kubeconfig.Contexts))
+	for name := range kubeconfig.Contexts {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+
+	fmt.Println(i18n.T("\nKubeconfig Context Listesi:"))
+	fmt.Printf("%-5s %-30s %-25s %-40s\n", "NO", "CONTEXT", "CLUSTER", "SERVER")
+	for i, name := range names {
+		ctx := kubeconfig.Contexts[name]
+		server := "N/A"
+		if cluster, exists := kubeconfig.Clusters[ctx.Cluster]; exists {
+			server = cluster.Server
+		}
 
-	client, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		fmt.Printf("Client oluşturulamadı: %v\n", err)
-		return
+		marker := ""
+		if name == kubeconfig.CurrentContext {
+			marker = " (current)"
+		}
+		if activeSpec.Method == MethodKubeconfig && name == activeSpec.Context {
+			marker += " (aktif)"
+		}
+		fmt.Printf("%-5d %-30s %-25s %-40s%s\n", i+1, name, ctx.Cluster, server, marker)
 	}
 
-	// Bağlantı başarılı olduğunda
-
-	KubeClient = client
+	i18n.Printf("\nContext seçin (1-%d, 0 için current-context): ", len(names))
+	var choice int
+	fmt.Scanf("%d", &choice)
 
-	// Kubeconfig'den cluster bilgisini al
-	kubeconfig, err := clientcmd.LoadFromFile(kubeconfigPath)
-	if err != nil {
-		fmt.Printf("Kubeconfig yüklenemedi: %v\n", err)
-		return
-	}
-	currentContext := kubeconfig.CurrentContext
-	if context, exists := kubeconfig.Contexts[currentContext]; exists {
-		if cluster, exists := kubeconfig.Clusters[context.Cluster]; exists {
-			activeConnection = fmt.Sprintf("Kubeconfig (%s)", cluster.Server)
+	if choice == 0 {
+		if kubeconfig.CurrentContext == "" {
+			return "", i18n.Errorf("kubeconfig dosyasında current-context tanımlı değil")
 		}
+		return kubeconfig.CurrentContext, nil
 	}
-
-	fmt.Printf("Kubeconfig ile bağlantı başarılı! (%s)\n", kubeconfigPath)
-	waitForMainMenu()
+	if choice < 0 || choice > len(names) {
+		return "", i18n.Errorf("geçersiz context seçimi")
+	}
+	return names[choice-1], nil
 }
 
 // Aktif bağlantı bilgisini dışarıya açan fonksiyon
 func GetActiveConnection() string {
 	return activeConnection
 }
+
+// ShowEffectiveConfig etkin yapılandırma değerlerini ve kaynak katmanlarını yazdırır
+func ShowEffectiveConfig() {
+	envManager.ShowEffectiveConfig()
+}
@@ -0,0 +1,422 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/utils"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// bootstrapSpec Y manifestindeki service account kurulumunun isimleri
+type bootstrapSpec struct {
+	Namespace      string
+	ServiceAccount string
+	ClusterRole    string
+	Binding        string
+	TokenDuration  time.Duration
+	Rules          []rbacv1.PolicyRule
+}
+
+// defaultBootstrapRules Y manifestindeki client-access-role kuralları
+var defaultBootstrapRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"namespaces", "nodes", "pods", "pods/log", "services", "configmaps",
+			"secrets", "persistentvolumes", "persistentvolumeclaims"},
+		Verbs: []string{"get", "list", "watch", "create", "delete"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets", "replicasets"},
+		Verbs:     []string{"get", "list", "watch", "create", "delete"},
+	},
+	{
+		APIGroups: []string{"metrics.k8s.io"},
+		Resources: []string{"pods", "nodes"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses", "ingressclasses"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"storage.k8s.io"},
+		Resources: []string{"storageclasses"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"rbac.authorization.k8s.io"},
+		Resources: []string{"roles", "rolebindings", "clusterroles", "clusterrolebindings"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+}
+
+// bootstrapServiceAccount yetkili bir kubeconfig bağlantısı üzerinden Y
+// manifestindeki kaynakları oluşturur, token üretir ve .env'i doldurur
+func bootstrapServiceAccount() {
+	if activeSession == nil || activeSpec.Method != MethodKubeconfig {
+		fmt.Println(i18n.T("Hata: Bu işlem için önce yetkili bir kubeconfig ile bağlanmalısınız!"))
+		return
+	}
+
+	fmt.Println(i18n.T("\n=== Service Account Erişimi Kur (Yönetici) ==="))
+	i18n.Printf("Kullanılan bağlantı: %s\n\n", activeConnection)
+
+	reader := bufio.NewReader(os.Stdin)
+	spec := bootstrapSpec{
+		Namespace:      promptDefault(reader, "Namespace", "client-access"),
+		ServiceAccount: promptDefault(reader, "Service Account", "client-access-sa"),
+		ClusterRole:    promptDefault(reader, "ClusterRole", "client-access-role"),
+		Binding:        promptDefault(reader, "ClusterRoleBinding", "client-access-binding"),
+	}
+
+	hours, err := strconv.Atoi(promptDefault(reader, i18n.T("Token geçerlilik süresi (saat)"), "24"))
+	if err != nil || hours <= 0 {
+		fmt.Println(i18n.T("Hata: Geçersiz süre!"))
+		return
+	}
+	spec.TokenDuration = time.Duration(hours) * time.Hour
+
+	fmt.Println(i18n.T("\nYetki kuralları:"))
+	fmt.Println(i18n.T("1. Varsayılan (Y manifestindeki client-access-role)"))
+	fmt.Println(i18n.T("2. Salt Okunur (varsayılan kaynaklar, yalnızca get/list/watch)"))
+	fmt.Println(i18n.T("3. YAML Dosyasından (ClusterRole içeren manifest)"))
+	switch promptDefault(reader, i18n.T("Seçiminiz (1-3)"), "1") {
+	case "1":
+		spec.Rules = defaultBootstrapRules
+	case "2":
+		spec.Rules = readOnlyRules(defaultBootstrapRules)
+	case "3":
+		path := promptDefault(reader, i18n.T("Manifest dosyası"), "Y")
+		spec.Rules, err = loadClusterRoleRules(path)
+		if err != nil {
+			i18n.Printf("Hata: %v\n", err)
+			return
+		}
+	default:
+		fmt.Println(i18n.T("Geçersiz seçim!"))
+		return
+	}
+
+	token, err := applyBootstrap(spec, func(question string) bool {
+		fmt.Print(question)
+		answer, _ := reader.ReadString('\n')
+		return i18n.IsYes(answer)
+	})
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	caPath, err := writeClusterCA(spec.Namespace)
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	if err := envManager.Set("K8S_TOKEN", token); err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+	envManager.Set("API_SERVER", activeSession.Config.Host)
+	envManager.Set("CA_CERT_PATH", caPath)
+	if err := envManager.Save(); err != nil {
+		i18n.Printf("Hata: .env dosyası kaydedilemedi: %v\n", err)
+		return
+	}
+
+	i18n.Printf("\n.env güncellendi: API_SERVER=%s, CA_CERT_PATH=%s, K8S_TOKEN (%s geçerli)\n",
+		activeSession.Config.Host, caPath, spec.TokenDuration)
+	if envManager.Get("K8S_TOKEN_FILE") != "" {
+		fmt.Println(i18n.T("Uyarı: K8S_TOKEN_FILE tanımlı; Service Account bağlantısında token dosyası öncelikli olacak."))
+	}
+	fmt.Println(i18n.T("Artık 'Service Account ile Bağlan' menüsünden bağlanabilirsiniz."))
+}
+
+// promptDefault kullanıcıdan değer okur, boş bırakılırsa varsayılanı döndürür
+func promptDefault(reader *bufio.Reader, label, def string) string {
+	fmt.Printf("%s [%s]: ", label, def)
+	line, _ := reader.ReadString('\n')
+	if line = strings.TrimSpace(line); line != "" {
+		return line
+	}
+	return def
+}
+
+// readOnlyRules kuralların fiillerini get/list/watch ile sınırlar
+func readOnlyRules(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	result := make([]rbacv1.PolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		rule.Verbs = []string{"get", "list", "watch"}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// loadClusterRoleRules çok dokümanlı bir manifestteki ilk ClusterRole'ün
+// kurallarını döndürür
+func loadClusterRoleRules(path string) ([]rbacv1.PolicyRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, i18n.Errorf("manifest okunamadı: %v", err)
+	}
+
+	decode := scheme.Codecs.UniversalDeserializer().Decode
+	for _, doc := range strings.Split(string(data), "\n---") {
+		obj, _, err := decode([]byte(doc), nil, nil)
+		if err != nil {
+			continue // Boş veya başka türde dokümanlar
+		}
+		if role, ok := obj.(*rbacv1.ClusterRole); ok {
+			if len(role.Rules) == 0 {
+				return nil, i18n.Errorf("'%s' ClusterRole'ünde kural yok", role.Name)
+			}
+			return role.Rules, nil
+		}
+	}
+	return nil, i18n.Errorf("%s içinde ClusterRole bulunamadı", path)
+}
+
+// managedByLabel bu aracın oluşturduğu kaynaklara eklenen etiket. Etiketi
+// taşımayan mevcut RBAC nesneleri (örn. yerleşik edit, admin) onay
+// alınmadan değiştirilmez.
+const managedByLabel, managedByValue = "app.kubernetes.io/managed-by", "tamerGoClient"
+
+// applyBootstrap kaynakları oluşturur, yalnızca bu aracın oluşturduğu
+// ClusterRole/ClusterRoleBinding'leri günceller ve TokenRequest ile yeni bir
+// token üretir. Başkasına ait bir nesne değişecekse farkı gösterip confirm ile
+// onay ister.
+func applyBootstrap(spec bootstrapSpec, confirm func(question string) bool) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	labels := map[string]string{managedByLabel: managedByValue}
+
+	_, err := activeSession.Client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: spec.Namespace, Labels: labels},
+	}, metav1.CreateOptions{})
+	if err := reportApply("Namespace", spec.Namespace, err); err != nil {
+		return "", err
+	}
+
+	_, err = activeSession.Client.CoreV1().ServiceAccounts(spec.Namespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: spec.ServiceAccount, Namespace: spec.Namespace, Labels: labels},
+	}, metav1.CreateOptions{})
+	if err := reportApply("ServiceAccount", spec.ServiceAccount, err); err != nil {
+		return "", err
+	}
+
+	roles := activeSession.Client.RbacV1().ClusterRoles()
+	existingRole, err := roles.Get(ctx, spec.ClusterRole, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = roles.Create(ctx, &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: spec.ClusterRole, Labels: labels},
+			Rules:      spec.Rules,
+		}, metav1.CreateOptions{})
+		if err := reportApply("ClusterRole", spec.ClusterRole, err); err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", i18n.Errorf("ClusterRole '%s' okunamadı: %v", spec.ClusterRole, err)
+	case equality.Semantic.DeepEqual(existingRole.Rules, spec.Rules):
+		i18n.Printf("%s '%s' zaten mevcut\n", "ClusterRole", spec.ClusterRole)
+	default:
+		if !isManaged(existingRole) {
+			i18n.Printf("\nUyarı: ClusterRole '%s' bu araç tarafından oluşturulmamış; kuralları değişecek:\n", spec.ClusterRole)
+			printDiff(ruleLines(existingRole.Rules), ruleLines(spec.Rules))
+			if !confirm(i18n.Sprintf("ClusterRole '%s' güncellensin mi? [e/h]: ", spec.ClusterRole)) {
+				return "", i18n.Errorf("ClusterRole '%s' değiştirilmedi; başka bir isim seçin", spec.ClusterRole)
+			}
+		}
+		existingRole.Rules = spec.Rules
+		if _, err := roles.Update(ctx, existingRole, metav1.UpdateOptions{}); err != nil {
+			return "", i18n.Errorf("ClusterRole '%s' güncellenemedi: %v", spec.ClusterRole, err)
+		}
+		i18n.Printf("ClusterRole '%s' güncellendi\n", spec.ClusterRole)
+	}
+
+	bindings := activeSession.Client.RbacV1().ClusterRoleBindings()
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: spec.Binding, Labels: labels},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      spec.ServiceAccount,
+			Namespace: spec.Namespace,
+		}},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     spec.ClusterRole,
+		},
+	}
+	existing, err := bindings.Get(ctx, spec.Binding, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = bindings.Create(ctx, binding, metav1.CreateOptions{})
+		if err := reportApply("ClusterRoleBinding", spec.Binding, err); err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", i18n.Errorf("ClusterRoleBinding '%s' okunamadı: %v", spec.Binding, err)
+	case existing.RoleRef == binding.RoleRef && equality.Semantic.DeepEqual(existing.Subjects, binding.Subjects):
+		i18n.Printf("%s '%s' zaten mevcut\n", "ClusterRoleBinding", spec.Binding)
+	default:
+		if !isManaged(existing) {
+			i18n.Printf("\nUyarı: ClusterRoleBinding '%s' bu araç tarafından oluşturulmamış; rol ve hesapları değişecek:\n", spec.Binding)
+			printDiff(bindingLines(existing.RoleRef, existing.Subjects), bindingLines(binding.RoleRef, binding.Subjects))
+			if !confirm(i18n.Sprintf("ClusterRoleBinding '%s' güncellensin mi? [e/h]: ", spec.Binding)) {
+				return "", i18n.Errorf("ClusterRoleBinding '%s' değiştirilmedi; başka bir isim seçin", spec.Binding)
+			}
+		}
+		if existing.RoleRef != binding.RoleRef {
+			// RoleRef değiştirilemediği için binding silinip yeniden oluşturulur
+			if err = bindings.Delete(ctx, spec.Binding, metav1.DeleteOptions{}); err == nil {
+				_, err = bindings.Create(ctx, binding, metav1.CreateOptions{})
+			}
+		} else {
+			existing.Subjects = binding.Subjects
+			_, err = bindings.Update(ctx, existing, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return "", i18n.Errorf("ClusterRoleBinding '%s' güncellenemedi: %v", spec.Binding, err)
+		}
+		i18n.Printf("ClusterRoleBinding '%s' güncellendi\n", spec.Binding)
+	}
+
+	tokenRequest, err := activeSession.Client.CoreV1().ServiceAccounts(spec.Namespace).CreateToken(ctx, spec.ServiceAccount,
+		&authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: utils.Int64(int64(spec.TokenDuration.Seconds())),
+			},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return "", i18n.Errorf("token oluşturulamadı: %v", err)
+	}
+	i18n.Printf("Token oluşturuldu, geçerlilik sonu: %s\n",
+		tokenRequest.Status.ExpirationTimestamp.Local().Format("2006-01-02 15:04:05"))
+	return tokenRequest.Status.Token, nil
+}
+
+// reportApply create sonucunu yazdırır; kaynak zaten varsa hata sayılmaz
+func reportApply(kind, name string, err error) error {
+	switch {
+	case err == nil:
+		i18n.Printf("%s '%s' oluşturuldu\n", kind, name)
+	case apierrors.IsAlreadyExists(err):
+		i18n.Printf("%s '%s' zaten mevcut\n", kind, name)
+	default:
+		return i18n.Errorf("%s '%s' oluşturulamadı: %v", kind, name, err)
+	}
+	return nil
+}
+
+func isManaged(obj metav1.Object) bool {
+	return obj.GetLabels()[managedByLabel] == managedByValue
+}
+
+// ruleLines kuralları satır satır karşılaştırılabilir metne çevirir
+func ruleLines(rules []rbacv1.PolicyRule) []string {
+	lines := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		groups := make([]string, len(rule.APIGroups))
+		for i, group := range rule.APIGroups {
+			if group == "" {
+				group = "core"
+			}
+			groups[i] = group
+		}
+		line := fmt.Sprintf("%s: %s [%s]", strings.Join(groups, ","), strings.Join(rule.Resources, ","), strings.Join(rule.Verbs, ","))
+		if len(rule.NonResourceURLs) > 0 {
+			line = fmt.Sprintf("%s [%s]", strings.Join(rule.NonResourceURLs, ","), strings.Join(rule.Verbs, ","))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// bindingLines binding'in rolünü ve hesaplarını satır satır metne çevirir
+func bindingLines(ref rbacv1.RoleRef, subjects []rbacv1.Subject) []string {
+	lines := []string{"roleRef: " + ref.Kind + "/" + ref.Name}
+	for _, subject := range subjects {
+		name := subject.Name
+		if subject.Namespace != "" {
+			name = subject.Namespace + "/" + name
+		}
+		lines = append(lines, "subject: "+subject.Kind+" "+name)
+	}
+	return lines
+}
+
+// printDiff kaldırılacak satırları "-", eklenecekleri "+" ile yazar
+func printDiff(current, desired []string) {
+	for _, line := range current {
+		if !slices.Contains(desired, line) {
+			fmt.Println("  - " + line)
+		}
+	}
+	for _, line := range desired {
+		if !slices.Contains(current, line) {
+			fmt.Println("  + " + line)
+		}
+	}
+}
+
+// writeClusterCA aktif bağlantının CA sertifikasını (yoksa namespace'teki
+// kube-root-ca.crt ConfigMap'ini) .env'in yanına yazar ve yolunu döndürür
+func writeClusterCA(namespace string) (string, error) {
+	caData := activeSession.Config.CAData
+	if len(caData) == 0 && activeSession.Config.CAFile != "" {
+		data, err := os.ReadFile(activeSession.Config.CAFile)
+		if err != nil {
+			return "", i18n.Errorf("CA sertifikası okunamadı: %v", err)
+		}
+		caData = data
+	}
+	if len(caData) == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		cm, err := activeSession.Client.CoreV1().ConfigMaps(namespace).Get(ctx, "kube-root-ca.crt", metav1.GetOptions{})
+		if err != nil {
+			return "", i18n.Errorf("cluster CA sertifikası bulunamadı: %v", err)
+		}
+		caData = []byte(cm.Data["ca.crt"])
+	}
+
+	path, err := filepath.Abs(filepath.Join(filepath.Dir(envManager.FilePath()), namespace+"-ca.crt"))
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, caData, 0644); err != nil {
+		return "", i18n.Errorf("CA sertifikası yazılamadı: %v", err)
+	}
+	i18n.Printf("CA sertifikası kaydedildi: %s\n", path)
+	return path, nil
+}
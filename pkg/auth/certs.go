@@ -0,0 +1,380 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+)
+
+// ErrPinMismatch sunucu sertifikası .env'deki pin ile eşleşmediğinde döner
+var ErrPinMismatch error = i18n.Error("sunucu sertifikası K8S_SERVER_CERT_SHA256 pin'i ile eşleşmiyor, bağlantı reddedildi")
+
+// certFingerprint sertifikanın SHA-256 parmak izini AA:BB:... biçiminde döndürür
+func certFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+// normalizeFingerprint karşılaştırma için "sha256:" önekini, iki nokta ve
+// boşlukları kaldırıp küçük harfe çevirir
+func normalizeFingerprint(fingerprint string) string {
+	fingerprint = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(fingerprint)), "sha256:")
+	return strings.NewReplacer(":", "", " ", "").Replace(fingerprint)
+}
+
+// validFingerprint değerin 64 haneli bir SHA-256 hex özeti olup olmadığını döndürür
+func validFingerprint(fingerprint string) bool {
+	decoded, err := hex.DecodeString(normalizeFingerprint(fingerprint))
+	return err == nil && len(decoded) == sha256.Size
+}
+
+// pinnedTransport client-go'nun temel HTTP transport'unu kopyalayıp TLS
+// el sıkışmasına pin kontrolü ekler. Kontrol istek gönderilmeden önce
+// yapıldığı için token eşleşmeyen sunucuya hiç iletilmez.
+func pinnedTransport(pin string) func(http.RoundTripper) http.RoundTripper {
+	expected := normalizeFingerprint(pin)
+	return func(rt http.RoundTripper) http.RoundTripper {
+		base, ok := rt.(*http.Transport)
+		if !ok {
+			return failingRoundTripper{err: i18n.Errorf("sertifika pin'i bu transport türüyle uygulanamıyor: %T", rt)}
+		}
+
+		pinned := base.Clone()
+		if pinned.TLSClientConfig == nil {
+			pinned.TLSClientConfig = &tls.Config{}
+		}
+		pinned.TLSClientConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return ErrPinMismatch
+			}
+			if normalizeFingerprint(certFingerprint(state.PeerCertificates[0])) != expected {
+				return ErrPinMismatch
+			}
+			return nil
+		}
+		return pinned
+	}
+}
+
+// failingRoundTripper pin uygulanamadığında güvenli tarafta kalıp her isteği reddeder
+type failingRoundTripper struct {
+	err error
+}
+
+func (f failingRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, f.err
+}
+
+// printCertificate sertifikanın önemli alanlarını yazdırır
+func printCertificate(cert *x509.Certificate) {
+	fmt.Printf("  Subject     : %s\n", cert.Subject)
+	fmt.Printf("  Issuer      : %s\n", cert.Issuer)
+	if sans := certSANs(cert); len(sans) > 0 {
+		fmt.Printf("  SAN         : %s\n", strings.Join(sans, ", "))
+	}
+	i18n.Printf("  Seri No     : %s\n", cert.SerialNumber)
+	fmt.Printf("  CA          : %t\n", cert.IsCA)
+	i18n.Printf("  Geçerlilik  : %s - %s\n", cert.NotBefore.Local().Format("2006-01-02 15:04"), cert.NotAfter.Local().Format("2006-01-02 15:04"))
+
+	switch remaining := time.Until(cert.NotAfter); {
+	case remaining <= 0:
+		fmt.Println(i18n.T("  Durum       : SÜRESİ DOLMUŞ!"))
+	case remaining < 30*24*time.Hour:
+		i18n.Printf("  Durum       : %d gün içinde sona erecek!\n", int(remaining.Hours()/24))
+	default:
+		i18n.Printf("  Durum       : %d gün geçerli\n", int(remaining.Hours()/24))
+	}
+	fmt.Printf("  SHA-256     : %s\n", certFingerprint(cert))
+}
+
+func certSANs(cert *x509.Certificate) []string {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return append(sans, cert.EmailAddresses...)
+}
+
+// parseCertificates PEM verisindeki tüm sertifikaları ayrıştırır
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, i18n.Errorf("PEM sertifika bulunamadı")
+	}
+	return certs, nil
+}
+
+// inspectionTarget incelenecek sunucu adresini ve CA verisini döndürür.
+// Aktif bağlantı varsa onun bilgileri, yoksa .env değerleri kullanılır.
+func inspectionTarget() (string, []byte, error) {
+	if activeSession != nil {
+		caData := activeSession.Config.CAData
+		if len(caData) == 0 && activeSession.Config.CAFile != "" {
+			data, err := os.ReadFile(activeSession.Config.CAFile)
+			if err != nil {
+				return "", nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+			}
+			caData = data
+		}
+		if len(caData) == 0 && envManager.Get("CA_CERT_PATH") != "" {
+			data, err := os.ReadFile(envManager.Get("CA_CERT_PATH"))
+			if err != nil {
+				return "", nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+			}
+			caData = data
+		}
+		return activeSession.Config.Host, caData, nil
+	}
+
+	server := envManager.Get("API_SERVER")
+	if server == "" {
+		return "", nil, i18n.Errorf("API_SERVER tanımlı değil ve aktif bağlantı yok")
+	}
+	var caData []byte
+	if path := envManager.Get("CA_CERT_PATH"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+		}
+		caData = data
+	}
+	return server, caData, nil
+}
+
+// fetchServerCertificates sunucunun sunduğu sertifika zincirini proxy
+// ayarlarına uyarak, kimlik bilgisi göndermeden ve doğrulama yapmadan alır
+func fetchServerCertificates(server string) ([]*x509.Certificate, error) {
+	settings, err := loadTransportSettings()
+	if err != nil {
+		return nil, err
+	}
+	// Yalnızca sertifikayı görmek için bağlanıyoruz; doğrulamayı kendimiz yaparız
+	settings.Insecure = true
+	settings.Pin = ""
+
+	config := &rest.Config{Host: server}
+	settings.apply(config)
+	client, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+"/version", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, i18n.Errorf("sunucuya bağlanılamadı: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return nil, i18n.Errorf("sunucu TLS sertifikası sunmadı")
+	}
+	return resp.TLS.PeerCertificates, nil
+}
+
+// verifyServerChain sunucu sertifikasını CA ile ve beklenen host adıyla doğrular
+func verifyServerChain(chain []*x509.Certificate, caData []byte, server string) error {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caData) {
+		return i18n.Errorf("CA verisi ayrıştırılamadı")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	host := envManager.Get("K8S_TLS_SERVER_NAME")
+	if host == "" {
+		if u, err := url.Parse(server); err == nil {
+			host = u.Hostname()
+		}
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+func showCertificateMenu() int {
+	fmt.Println(i18n.T("\n=== Sertifika İnceleme ==="))
+	if pin := envManager.Get("K8S_SERVER_CERT_SHA256"); pin != "" {
+		i18n.Printf("(Sunucu sertifikası pin'i: %s)\n", pin)
+	}
+	fmt.Println(i18n.T("1. CA Sertifikasını Göster"))
+	fmt.Println(i18n.T("2. Sunucu Sertifikasını Göster"))
+	fmt.Println(i18n.T("3. Sunucu Sertifikasını Pinle"))
+	fmt.Println(i18n.T("4. Pin'i Kaldır"))
+	fmt.Println(i18n.T("5. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-5): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+func handleCertificateMenu() {
+	for {
+		choice := showCertificateMenu()
+
+		switch choice {
+		case 1:
+			showCACertificate()
+		case 2:
+			showServerCertificate()
+		case 3:
+			pinServerCertificate()
+		case 4:
+			envManager.Set("K8S_SERVER_CERT_SHA256", "")
+			if err := envManager.Save(); err != nil {
+				i18n.Printf("Hata: .env dosyası kaydedilemedi: %v\n", err)
+				continue
+			}
+			fmt.Println(i18n.T("Pin kaldırıldı. Bir sonraki bağlantıda geçerli olacak."))
+		case 5:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+		}
+	}
+}
+
+func showCACertificate() {
+	_, caData, err := inspectionTarget()
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+	if len(caData) == 0 {
+		fmt.Println(i18n.T("CA sertifikası yok (sistem sertifika deposu kullanılıyor)."))
+		return
+	}
+
+	certs, err := parseCertificates(caData)
+	if err != nil {
+		i18n.Printf("Hata: CA sertifikası ayrıştırılamadı: %v\n", err)
+		return
+	}
+	for i, cert := range certs {
+		i18n.Printf("\nCA Sertifikası #%d:\n", i+1)
+		printCertificate(cert)
+	}
+}
+
+func showServerCertificate() {
+	server, caData, err := inspectionTarget()
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	chain, err := fetchServerCertificates(server)
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	i18n.Printf("\n%s sunucusunun sertifika zinciri:\n", server)
+	for i, cert := range chain {
+		if i == 0 {
+			fmt.Println(i18n.T("\nSunucu Sertifikası:"))
+		} else {
+			i18n.Printf("\nAra Sertifika #%d:\n", i)
+		}
+		printCertificate(cert)
+	}
+
+	fmt.Println()
+	if len(caData) > 0 {
+		if err := verifyServerChain(chain, caData, server); err != nil {
+			i18n.Printf("CA ile doğrulama: BAŞARISIZ (%v)\n", err)
+		} else {
+			fmt.Println(i18n.T("CA ile doğrulama: Başarılı"))
+		}
+	}
+	if pin := envManager.Get("K8S_SERVER_CERT_SHA256"); pin != "" {
+		if normalizeFingerprint(pin) == normalizeFingerprint(certFingerprint(chain[0])) {
+			fmt.Println(i18n.T("Pin kontrolü: Eşleşiyor"))
+		} else {
+			fmt.Println(i18n.T("Pin kontrolü: EŞLEŞMİYOR! Sunucu sertifikası değişmiş olabilir."))
+		}
+	}
+}
+
+// pinServerCertificate sunucunun güncel sertifikasının parmak izini .env'e yazar
+func pinServerCertificate() {
+	server, caData, err := inspectionTarget()
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	chain, err := fetchServerCertificates(server)
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+
+	fmt.Println(i18n.T("\nPinlenecek sunucu sertifikası:"))
+	printCertificate(chain[0])
+	if len(caData) > 0 {
+		if err := verifyServerChain(chain, caData, server); err != nil {
+			i18n.Printf("\nUyarı: Sertifika CA ile doğrulanamadı: %v\n", err)
+		}
+	}
+
+	fmt.Print(i18n.T("\nParmak izini başka bir kanaldan doğruladıysanız pinlemek için 'evet' yazın: "))
+	var confirm string
+	fmt.Scanf("%s", &confirm)
+	if confirm != i18n.T("evet") {
+		fmt.Println(i18n.T("İşlem iptal edildi."))
+		return
+	}
+
+	envManager.Set("K8S_SERVER_CERT_SHA256", certFingerprint(chain[0]))
+	if err := envManager.Save(); err != nil {
+		i18n.Printf("Hata: .env dosyası kaydedilemedi: %v\n", err)
+		return
+	}
+	fmt.Println(i18n.T("Pin kaydedildi. Sunucu sertifikası değişirse bağlantı reddedilecek."))
+}
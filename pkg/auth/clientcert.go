@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+)
+
+func showClientCertMenu() int {
+	fmt.Println(i18n.T("\n=== Client Sertifikası (mTLS) Bağlantı Menüsü ==="))
+	if activeSpec.Method == MethodClientCert {
+		i18n.Printf("(Aktif Bağlantı: via %s)\n", activeConnection)
+	}
+	fmt.Println(i18n.T("\nGerekli .env değerleri:"))
+	fmt.Println(i18n.T("- API_SERVER: Kubernetes API sunucu adresi"))
+	fmt.Println(i18n.T("- CLIENT_CERT_PATH veya CLIENT_CERT_DATA: Client sertifikası (dosya yolu veya PEM)"))
+	fmt.Println(i18n.T("- CLIENT_KEY_PATH veya CLIENT_KEY_DATA: Client anahtarı (dosya yolu veya PEM)"))
+	fmt.Println(i18n.T("- CA_CERT_PATH veya CA_CERT_DATA: CA sertifikası (dosya yolu veya PEM)"))
+	fmt.Println(i18n.T("\nSeçenekler:"))
+	fmt.Println(i18n.T("1. Bağlantıyı Yapılandır"))
+	fmt.Println(i18n.T("2. .env Dosyasını Düzenle"))
+	fmt.Println(i18n.T("3. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-3): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+func handleClientCertMenu() {
+	for {
+		choice := showClientCertMenu()
+
+		switch choice {
+		case 1:
+			connectWithClientCert()
+			return // Bağlantı başarılı olduğunda direkt ana menüye dön
+		case 2:
+			envManager.ShowEnvMenu()
+		case 3:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+		}
+	}
+}
+
+func connectWithClientCert() {
+	// .env'den değerleri oku
+	p := profileFromEnv(MethodClientCert)
+
+	// Değerler eksikse kullanıcıdan al (inline PEM yalnızca .env üzerinden verilebilir)
+	if p.ServerURL == "" {
+		fmt.Print("Kubernetes API Server URL: ")
+		fmt.Scanf("%s", &p.ServerURL)
+		envManager.Set("API_SERVER", p.ServerURL)
+	}
+
+	if p.ClientCertPath == "" && p.ClientCertData == "" {
+		fmt.Print(i18n.T("Client sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.ClientCertPath)
+		envManager.Set("CLIENT_CERT_PATH", p.ClientCertPath)
+	}
+
+	if p.ClientKeyPath == "" && p.ClientKeyData == "" {
+		fmt.Print(i18n.T("Client anahtar dosya yolu: "))
+		fmt.Scanf("%s", &p.ClientKeyPath)
+		envManager.Set("CLIENT_KEY_PATH", p.ClientKeyPath)
+	}
+
+	if p.CACertPath == "" && p.CACertData == "" {
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+		envManager.Set("CA_CERT_PATH", p.CACertPath)
+	}
+
+	// Değişiklikleri kaydet
+	if err := envManager.Save(); err != nil {
+		i18n.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
+	}
+
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("Client sertifikası ile bağlantı başarısız: %v\n", err)
+		return
+	}
+
+	fmt.Println(i18n.T("Client sertifikası ile bağlantı başarılı!"))
+	waitForMainMenu()
+}
+
+// loadPEM inline PEM verisi varsa onu, yoksa dosya yolundaki içeriği döndürür.
+// Inline değer .env'de tek satır tutulabilsin diye "\n" kaçışlı PEM
+// veya kubeconfig'deki gibi base64 kodlanmış PEM olabilir.
+func loadPEM(path, inline, what string) ([]byte, error) {
+	if inline != "" {
+		if strings.Contains(inline, "-----BEGIN") {
+			return []byte(strings.ReplaceAll(inline, `\n`, "\n")), nil
+		}
+		data, err := base64.StdEncoding.DecodeString(inline)
+		if err != nil {
+			return nil, i18n.Errorf("%s verisi PEM veya base64 formatında değil: %v", what, err)
+		}
+		return data, nil
+	}
+
+	if path == "" {
+		return nil, i18n.Errorf("%s için dosya yolu veya PEM verisi gerekli", what)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, i18n.Errorf("%s okunamadı: %v", what, err)
+	}
+	return data, nil
+}
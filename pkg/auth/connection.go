@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Desteklenen kimlik doğrulama yöntemleri
+const (
+	MethodServiceAccount = "ServiceAccount"
+	MethodInCluster      = "In-Cluster"
+	MethodKubeconfig     = "Kubeconfig"
+	MethodClientCert     = "ClientCert"
+	MethodExec           = "Exec"
+	MethodOIDC           = "OIDC"
+)
+
+var (
+	activeSession    *session.Session // Aktif bağlantının oturumu (bağlantı yoksa nil)
+	activeBaseConfig *rest.Config     // Oturum config'inin kimliğe bürünme uygulanmamış hali
+)
+
+// connectProfile profil bilgileriyle bağlanır ve başarılı bağlantıyı bir
+// sonraki açılışta geri yüklenmek üzere kaydeder
+func connectProfile(p Profile) error {
+	if err := connectSession(p); err != nil {
+		return err
+	}
+	if err := rememberConnection(p); err != nil {
+		i18n.Fprintf(os.Stderr, "Uyarı: Son bağlantı bilgisi kaydedilemedi: %v\n", err)
+	}
+	return nil
+}
+
+// connectSession profil bilgileriyle client oluşturur, gerekiyorsa bağlantıyı
+// test eder ve başarılı olursa aktif bağlantı olarak ayarlar
+func connectSession(p Profile) error {
+	config, err := buildRestConfig(p)
+	if err != nil {
+		return err
+	}
+
+	// Proxy, TLS ve limit ayarları tüm yöntemlere aynı şekilde uygulanır
+	transport, err := loadTransportSettings()
+	if err != nil {
+		return err
+	}
+	transport.apply(config)
+	if transport.Insecure {
+		warnInsecure()
+	}
+
+	// Unauthorized yanıtlarında kimlik bilgilerini yenileyebilmek için transport'u sar
+	reauthActive := &atomic.Bool{}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &reauthRoundTripper{next: rt, active: reauthActive}
+	})
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return i18n.Errorf("client oluşturulamadı: %v", err)
+	}
+
+	// Elle girilen bilgilerle kurulan ve sertifikası pinlenmiş bağlantıları doğrula
+	if (p.Method != MethodInCluster && p.Method != MethodKubeconfig) || transport.Pin != "" {
+		if err := verifyConnection(client); err != nil {
+			return err
+		}
+	}
+
+	// Doğrulama kendi kimliğimizle yapılır, bürünme ayarı sonradan uygulanır
+	baseConfig := config
+	if impersonationEnabled {
+		config = impersonatedConfig(baseConfig)
+	}
+
+	// Mevcut oturum nesnesi güncellenir ki menülerin elindeki referans
+	// yeniden bağlanma sonrası da geçerli kalsın
+	description := describeConnection(p, config)
+	if activeSession == nil {
+		if activeSession, err = session.New(description, config); err != nil {
+			return i18n.Errorf("client oluşturulamadı: %v", err)
+		}
+		// Sonraki bağlantılarda menüden seçilen biçim korunur
+		activeSession.Settings.Output = envManager.Get("OUTPUT_FORMAT")
+	} else if err := activeSession.SetConfig(config); err != nil {
+		return i18n.Errorf("client oluşturulamadı: %v", err)
+	}
+	activeSession.Name = description
+	activeSession.Namespace = envManager.Get("K8S_NAMESPACE")
+	// K8S_DEBUG=true listelemelerin API çağrısı sayısını ve süresini gösterir
+	activeSession.Settings.Debug, _ = strconv.ParseBool(envManager.Get("K8S_DEBUG"))
+	activeSession.FallbackNamespace = detectNamespace(p, baseConfig)
+
+	activeSpec = p
+	activeBaseConfig = baseConfig
+	activeTransport = transport
+	activeConnection = description
+	activeToken = currentToken(config)
+	reauthActive.Store(true)
+	return nil
+}
+
+// Current aktif bağlantının oturumunu döndürür, bağlantı yoksa nil döner
+func Current() *session.Session {
+	return activeSession
+}
+
+// profileFromEnv .env tabanlı yöntemler için bağlantı bilgilerini katmanlı
+// yapılandırmadan okur. Eksik değerleri doldurmak çağıranın sorumluluğundadır.
+func profileFromEnv(method string) Profile {
+	p := Profile{
+		Method:     method,
+		ServerURL:  envManager.Get("API_SERVER"),
+		CACertPath: envManager.Get("CA_CERT_PATH"),
+	}
+
+	switch method {
+	case MethodServiceAccount:
+		p.Token = envManager.Get("K8S_TOKEN")
+		p.TokenFile = envManager.Get("K8S_TOKEN_FILE")
+	case MethodClientCert:
+		p.ClientCertPath = envManager.Get("CLIENT_CERT_PATH")
+		p.ClientCertData = envManager.Get("CLIENT_CERT_DATA")
+		p.ClientKeyPath = envManager.Get("CLIENT_KEY_PATH")
+		p.ClientKeyData = envManager.Get("CLIENT_KEY_DATA")
+		p.CACertData = envManager.Get("CA_CERT_DATA")
+	case MethodExec:
+		p.ExecCommand = envManager.Get("EXEC_COMMAND")
+		p.ExecArgs = execArgsFromEnv()
+		p.ExecEnv = splitList(envManager.Get("EXEC_ENV"))
+		p.ExecAPIVersion = envManager.Get("EXEC_API_VERSION")
+	case MethodOIDC:
+		p.OIDCIssuerURL = envManager.Get("OIDC_ISSUER_URL")
+		p.OIDCClientID = envManager.Get("OIDC_CLIENT_ID")
+		p.OIDCClientSecret = envManager.Get("OIDC_CLIENT_SECRET")
+		p.OIDCRefreshToken = envManager.Get("OIDC_REFRESH_TOKEN")
+		p.OIDCIDToken = envManager.Get("OIDC_ID_TOKEN")
+	}
+	return p
+}
+
+// buildRestConfig profildeki yönteme göre rest config oluşturur
+func buildRestConfig(p Profile) (*rest.Config, error) {
+	switch p.Method {
+	case MethodServiceAccount:
+		caData, err := os.ReadFile(p.CACertPath)
+		if err != nil {
+			return nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+		}
+		return &rest.Config{
+			Host:            p.ServerURL,
+			BearerToken:     p.Token,
+			BearerTokenFile: p.TokenFile, // Varsa client-go dosyayı periyodik olarak yeniden okur
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData:   caData,
+				Insecure: false,
+			},
+		}, nil
+	case MethodClientCert:
+		certData, err := loadPEM(p.ClientCertPath, p.ClientCertData, i18n.T("client sertifikası"))
+		if err != nil {
+			return nil, err
+		}
+		keyData, err := loadPEM(p.ClientKeyPath, p.ClientKeyData, i18n.T("client anahtarı"))
+		if err != nil {
+			return nil, err
+		}
+		caData, err := loadPEM(p.CACertPath, p.CACertData, i18n.T("CA sertifikası"))
+		if err != nil {
+			return nil, err
+		}
+		return &rest.Config{
+			Host: p.ServerURL,
+			TLSClientConfig: rest.TLSClientConfig{
+				CertData: certData,
+				KeyData:  keyData,
+				CAData:   caData,
+				Insecure: false,
+			},
+		}, nil
+	case MethodExec, MethodOIDC:
+		caData, err := os.ReadFile(p.CACertPath)
+		if err != nil {
+			return nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+		}
+		config := &rest.Config{
+			Host: p.ServerURL,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData:   caData,
+				Insecure: false,
+			},
+		}
+		if p.Method == MethodExec {
+			config.ExecProvider = execProviderConfig(p)
+		} else {
+			config.AuthProvider = oidcAuthProvider(p)
+			config.AuthConfigPersister = oidcPersister{profileName: p.Name}
+		}
+		return config, nil
+	case MethodInCluster:
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, i18n.Errorf("in-cluster config oluşturulamadı: %v", err)
+		}
+		return config, nil
+	case MethodKubeconfig:
+		kubeconfig, err := clientcmd.LoadFromFile(p.KubeconfigPath)
+		if err != nil {
+			return nil, i18n.Errorf("kubeconfig yüklenemedi: %v", err)
+		}
+		if _, exists := kubeconfig.Contexts[p.Context]; !exists {
+			return nil, i18n.Errorf("'%s' context'i bulunamadı", p.Context)
+		}
+		clientConfig := clientcmd.NewNonInteractiveClientConfig(*kubeconfig, p.Context, &clientcmd.ConfigOverrides{}, nil)
+		config, err := clientConfig.ClientConfig()
+		if err != nil {
+			return nil, i18n.Errorf("context yapılandırması oluşturulamadı: %v", err)
+		}
+		return config, nil
+	default:
+		return nil, i18n.Errorf("desteklenmeyen kimlik doğrulama yöntemi: %s", p.Method)
+	}
+}
+
+// verifyConnection namespace listesini çekerek bağlantıyı ve kimliği test eder
+func verifyConnection(client kubernetes.Interface) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{}); err != nil {
+		// 403 kimliğin doğrulandığını gösterir; yalnızca kendi namespace'inde
+		// yetkisi olan kullanıcılar namespace kapsamlı modda çalışır
+		if apierrors.IsForbidden(err) {
+			return nil
+		}
+		return i18n.Errorf("bağlantı testi başarısız: %v", err)
+	}
+	return nil
+}
+
+// describeConnection menü başlıklarında gösterilecek bağlantı açıklamasını üretir
+func describeConnection(p Profile, config *rest.Config) string {
+	switch p.Method {
+	case MethodServiceAccount:
+		return fmt.Sprintf("ServiceAccount (%s)", p.ServerURL)
+	case MethodClientCert, MethodExec, MethodOIDC:
+		return fmt.Sprintf("%s (%s)", p.Method, p.ServerURL)
+	case MethodKubeconfig:
+		return fmt.Sprintf("Kubeconfig (%s @ %s)", p.Context, config.Host)
+	default:
+		return p.Method
+	}
+}
@@ -0,0 +1,367 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// maxClockSkew bu değerden büyük saat farkı token doğrulamasını bozabilir
+const maxClockSkew = 30 * time.Second
+
+// checkStatus tanılama adımının sonucu
+type checkStatus int
+
+const (
+	checkPass checkStatus = iota
+	checkWarn
+	checkFail
+	checkSkip
+)
+
+// String sabit genişlikli durum etiketini döndürür; çeviriler de aynı
+// genişlikte tutulur ki rapor sütunları hizalı kalsın
+func (s checkStatus) String() string {
+	switch s {
+	case checkPass:
+		return i18n.T("[ OK  ]")
+	case checkWarn:
+		return i18n.T("[UYARI]")
+	case checkFail:
+		return i18n.T("[HATA ]")
+	default:
+		return i18n.T("[ATLA ]")
+	}
+}
+
+// diagnostics adımların sonuçlarını toplar ve rapor olarak yazdırır
+type diagnostics struct {
+	counts map[checkStatus]int
+}
+
+func (d *diagnostics) report(status checkStatus, title, detail, hint string) {
+	d.counts[status]++
+	fmt.Printf("%s %s", status, title)
+	if detail != "" {
+		fmt.Printf(": %s", detail)
+	}
+	fmt.Println()
+	if hint != "" && (status == checkFail || status == checkWarn) {
+		i18n.Printf("        İpucu: %s\n", hint)
+	}
+}
+
+// RunDiagnostics .env'deki Service Account bağlantı bilgilerini adım adım
+// kontrol eder ve raporu yazdırır. Hata bulunmazsa true döner.
+func RunDiagnostics() bool {
+	d := &diagnostics{counts: make(map[checkStatus]int)}
+	fmt.Println(i18n.T("\n=== Bağlantı Tanılama ==="))
+
+	server := envManager.Get("API_SERVER")
+	caPath := envManager.Get("CA_CERT_PATH")
+	tokenFile := envManager.Get("K8S_TOKEN_FILE")
+	token := envManager.Get("K8S_TOKEN")
+
+	// 1. Yapılandırma anahtarları
+	for _, key := range []string{"API_SERVER", "CA_CERT_PATH"} {
+		if value, source := envManager.Lookup(key); value != "" {
+			d.report(checkPass, key, i18n.Sprintf("tanımlı (%s)", source), "")
+		} else {
+			d.report(checkFail, key, i18n.T("tanımlı değil"), i18n.Sprintf(".env menüsünden veya --set %s=... ile tanımlayın", key))
+		}
+	}
+	switch {
+	case tokenFile != "":
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			d.report(checkFail, "K8S_TOKEN_FILE", err.Error(), i18n.T("Dosya yolunu ve okuma iznini kontrol edin"))
+		} else {
+			token = strings.TrimSpace(string(data))
+			d.report(checkPass, "K8S_TOKEN_FILE", tokenFile, "")
+		}
+	case token != "":
+		_, source := envManager.Lookup("K8S_TOKEN")
+		d.report(checkPass, "K8S_TOKEN", i18n.Sprintf("tanımlı (%s)", source), "")
+	default:
+		d.report(checkFail, "K8S_TOKEN", i18n.T("K8S_TOKEN veya K8S_TOKEN_FILE tanımlı değil"),
+			i18n.T("Token'ı 'kubectl create token client-access-sa -n client-access' ile üretebilirsiniz"))
+	}
+
+	// 2. CA sertifikası
+	caData := checkCACertificate(d, caPath)
+
+	// 3. Token
+	checkToken(d, token)
+
+	// 4. DNS
+	u, err := url.Parse(server)
+	if server == "" || err != nil || u.Host == "" {
+		d.report(checkFail, i18n.T("API sunucu adresi"), i18n.Sprintf("geçersiz URL: %q", server), i18n.T("https://host:6443 biçiminde olmalı"))
+		return d.summary()
+	}
+	if u.Scheme != "https" {
+		d.report(checkWarn, i18n.T("API sunucu adresi"), i18n.T("şema https değil: ")+u.Scheme, i18n.T("API sunucusu normalde https üzerinden hizmet verir"))
+	}
+	checkDNS(d, u.Hostname())
+
+	// 5. Sunucu uç noktaları
+	transport, err := loadTransportSettings()
+	if err != nil {
+		d.report(checkFail, i18n.T("Bağlantı ayarları"), err.Error(), i18n.T("K8S_PROXY_URL, K8S_QPS, K8S_TIMEOUT vb. değerleri düzeltin"))
+		return d.summary()
+	}
+	config := &rest.Config{
+		Host:            server,
+		BearerToken:     token,
+		TLSClientConfig: rest.TLSClientConfig{CAData: caData},
+	}
+	transport.apply(config)
+
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		d.report(checkFail, i18n.T("HTTP client"), err.Error(), "")
+		return d.summary()
+	}
+
+	serverDate, reachable := checkEndpoint(d, httpClient, server, "/version")
+	if !reachable {
+		d.report(checkSkip, i18n.T("Kalan adımlar"), i18n.T("API sunucusuna ulaşılamadı"), "")
+		return d.summary()
+	}
+	checkEndpoint(d, httpClient, server, "/readyz")
+	checkClockSkew(d, serverDate)
+
+	client, err := kubernetes.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		d.report(checkFail, i18n.T("Client"), err.Error(), "")
+		return d.summary()
+	}
+
+	if groups, err := client.Discovery().ServerGroups(); err != nil {
+		d.report(checkFail, i18n.T("API discovery"), err.Error(), i18n.T("Token'ın discovery yetkisi olduğundan emin olun"))
+	} else {
+		d.report(checkPass, i18n.T("API discovery"), i18n.Sprintf("%d API grubu", len(groups.Groups)), "")
+	}
+
+	// 6. RBAC
+	namespace := envManager.Get("K8S_NAMESPACE")
+	if claims, err := decodeJWT(token); namespace == "" && err == nil {
+		namespace = claims.namespace()
+	}
+	checkNamespaceAccess(d, client, namespace)
+
+	return d.summary()
+}
+
+func (d *diagnostics) summary() bool {
+	i18n.Printf("\nSonuç: %d başarılı, %d uyarı, %d hata\n", d.counts[checkPass], d.counts[checkWarn], d.counts[checkFail])
+	return d.counts[checkFail] == 0
+}
+
+// checkCACertificate CA dosyasını ayrıştırır, geçerlilik tarihlerini kontrol
+// eder ve sonraki adımlarda kullanılmak üzere içeriğini döndürür
+func checkCACertificate(d *diagnostics, path string) []byte {
+	if path == "" {
+		d.report(checkSkip, i18n.T("CA sertifikası"), i18n.T("CA_CERT_PATH tanımlı değil"), "")
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		d.report(checkFail, i18n.T("CA sertifikası"), err.Error(), i18n.T("Dosya yolunu ve okuma iznini kontrol edin"))
+		return nil
+	}
+
+	certs, err := parseCertificates(data)
+	if err != nil {
+		d.report(checkFail, i18n.T("CA sertifikası"), i18n.T("ayrıştırılamadı: ")+err.Error(),
+			i18n.T("Dosya PEM formatında olmalı; base64 kodlu ca.crt değerini 'base64 -d' ile çözüp kaydedin"))
+		return nil
+	}
+
+	now := time.Now()
+	for _, cert := range certs {
+		subject := cert.Subject.CommonName
+		switch {
+		case now.After(cert.NotAfter):
+			d.report(checkFail, i18n.T("CA sertifikası"), i18n.Sprintf("%s süresi dolmuş (%s)", subject, cert.NotAfter.Format("2006-01-02")),
+				i18n.T("Cluster'ın güncel CA sertifikasını alın"))
+		case now.Before(cert.NotBefore):
+			d.report(checkFail, i18n.T("CA sertifikası"), i18n.Sprintf("%s henüz geçerli değil (%s)", subject, cert.NotBefore.Format("2006-01-02")),
+				i18n.T("Sistem saatini kontrol edin"))
+		case !cert.IsCA:
+			d.report(checkWarn, i18n.T("CA sertifikası"), subject+i18n.T(" bir CA sertifikası değil"),
+				i18n.T("Sunucu sertifikası yerine cluster CA'sını kullanın"))
+		default:
+			d.report(checkPass, i18n.T("CA sertifikası"), i18n.Sprintf("%s, %s tarihine kadar geçerli", subject, cert.NotAfter.Format("2006-01-02")), "")
+		}
+	}
+	return data
+}
+
+// checkToken token'ın iyi biçimli bir JWT olduğunu ve süresinin dolmadığını kontrol eder
+func checkToken(d *diagnostics, token string) {
+	if token == "" {
+		d.report(checkSkip, i18n.T("Token"), i18n.T("token yok"), "")
+		return
+	}
+
+	claims, err := decodeJWT(token)
+	if err != nil {
+		d.report(checkFail, i18n.T("Token"), err.Error(), i18n.T("Token'ın eksiksiz ve tırnaksız kopyalandığından emin olun"))
+		return
+	}
+
+	detail := fmt.Sprintf("sub=%s", claims.Subject)
+	if claims.ExpiresAt == 0 {
+		d.report(checkPass, i18n.T("Token"), detail+i18n.T(", süresiz"), "")
+		return
+	}
+	expiry := time.Unix(claims.ExpiresAt, 0)
+	if remaining := time.Until(expiry); remaining <= 0 {
+		d.report(checkFail, i18n.T("Token"), i18n.Sprintf("%s, süresi dolmuş (%s)", detail, expiry.Local().Format("2006-01-02 15:04:05")),
+			i18n.T("Yeni token üretin veya K8S_TOKEN_FILE ile dönen bir token kullanın"))
+	} else if remaining < tokenExpiryWarningWindow {
+		d.report(checkWarn, i18n.T("Token"), i18n.Sprintf("%s, %s içinde sona erecek", detail, remaining.Round(time.Second)),
+			i18n.T("Token'ı yakında yenileyin"))
+	} else {
+		d.report(checkPass, i18n.T("Token"), i18n.Sprintf("%s, %s tarihine kadar geçerli", detail, expiry.Local().Format("2006-01-02 15:04:05")), "")
+	}
+}
+
+func checkDNS(d *diagnostics, host string) {
+	if net.ParseIP(host) != nil {
+		d.report(checkSkip, i18n.T("DNS çözümleme"), host+i18n.T(" bir IP adresi"), "")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		d.report(checkFail, i18n.T("DNS çözümleme"), err.Error(), i18n.T("Host adını, /etc/hosts ve DNS ayarlarını kontrol edin"))
+		return
+	}
+	d.report(checkPass, i18n.T("DNS çözümleme"), fmt.Sprintf("%s -> %s", host, strings.Join(addrs, ", ")), "")
+}
+
+// checkEndpoint uç noktaya GET isteği atar, sunucunun Date başlığını ve
+// sunucuya ulaşılıp ulaşılamadığını döndürür
+func checkEndpoint(d *diagnostics, client *http.Client, server, path string) (time.Time, bool) {
+	resp, err := client.Get(strings.TrimSuffix(server, "/") + path)
+	if err != nil {
+		hint := i18n.T("Ağ erişimini, proxy ayarlarını ve güvenlik duvarını kontrol edin")
+		if strings.Contains(err.Error(), "certificate") {
+			hint = i18n.T("CA_CERT_PATH doğru cluster CA'sını göstermeli; gerekirse K8S_TLS_SERVER_NAME ayarlayın")
+		}
+		d.report(checkFail, path, err.Error(), hint)
+		return time.Time{}, false
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+	var serverDate time.Time
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		serverDate = date
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		d.report(checkPass, path, strings.Join(strings.Fields(string(body)), " "), "")
+	case http.StatusUnauthorized:
+		d.report(checkFail, path, resp.Status, i18n.T("Token geçersiz veya süresi dolmuş"))
+	case http.StatusForbidden:
+		d.report(checkWarn, path, resp.Status, i18n.T("Sunucuya erişiliyor ancak bu uç nokta için yetki yok"))
+	default:
+		d.report(checkFail, path, resp.Status, i18n.T("API sunucusunun durumunu kontrol edin"))
+	}
+	return serverDate, true
+}
+
+func checkClockSkew(d *diagnostics, serverDate time.Time) {
+	if serverDate.IsZero() {
+		d.report(checkSkip, i18n.T("Saat farkı"), i18n.T("sunucu zamanı alınamadı"), "")
+		return
+	}
+
+	skew := time.Since(serverDate)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		d.report(checkFail, i18n.T("Saat farkı"), i18n.Sprintf("yerel saat sunucudan %s farklı", skew.Round(time.Second)),
+			i18n.T("NTP ile sistem saatini senkronize edin; token doğrulaması başarısız olabilir"))
+		return
+	}
+	d.report(checkPass, i18n.T("Saat farkı"), fmt.Sprintf("~%s", skew.Round(time.Second)), "")
+}
+
+// checkNamespaceAccess namespace listeleme yetkisini hem SelfSubjectAccessReview
+// hem de gerçek bir istekle kontrol eder. Cluster genelinde yetki yoksa ve bir
+// namespace biliniyorsa o namespace'teki pod listeleme yetkisine bakılır.
+func checkNamespaceAccess(d *diagnostics, client kubernetes.Interface, namespace string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	allowed, err := canI(ctx, client, "list", "namespaces", "")
+	if err != nil {
+		d.report(checkWarn, i18n.T("RBAC (list namespaces)"), i18n.T("yetki sorgulanamadı: ")+err.Error(), "")
+	} else if !allowed {
+		if namespace == "" {
+			d.report(checkFail, i18n.T("RBAC (list namespaces)"), i18n.T("izin yok"),
+				i18n.T("Service account'u client-access-role'e bağlayan ClusterRoleBinding'i kontrol edin (Y manifesti) veya K8S_NAMESPACE tanımlayın"))
+			return
+		}
+		d.report(checkWarn, i18n.T("RBAC (list namespaces)"), i18n.T("izin yok, namespace kapsamlı modda çalışılacak"), "")
+		checkScopedAccess(ctx, d, client, namespace)
+		return
+	}
+
+	if _, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		d.report(checkFail, i18n.T("RBAC (list namespaces)"), err.Error(), i18n.T("Y manifestindeki ClusterRole ve binding'i uygulayın"))
+		return
+	}
+	d.report(checkPass, i18n.T("RBAC (list namespaces)"), i18n.T("izin var"), "")
+}
+
+// checkScopedAccess yalnızca kendi namespace'inde yetkisi olan kullanıcılar için
+// pod listeleme yetkisini kontrol eder
+func checkScopedAccess(ctx context.Context, d *diagnostics, client kubernetes.Interface, namespace string) {
+	title := i18n.Sprintf("RBAC (list pods -n %s)", namespace)
+	allowed, err := canI(ctx, client, "list", "pods", namespace)
+	switch {
+	case err != nil:
+		d.report(checkWarn, title, i18n.T("yetki sorgulanamadı: ")+err.Error(), "")
+	case !allowed:
+		d.report(checkFail, title, i18n.T("izin yok"), i18n.T("Namespace'teki Role ve RoleBinding'i kontrol edin"))
+	default:
+		d.report(checkPass, title, i18n.T("izin var"), "")
+	}
+}
+
+// canI SelfSubjectAccessReview ile tek bir yetkiyi sorgular
+func canI(ctx context.Context, client kubernetes.Interface, verb, resource, namespace string) (bool, error) {
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx,
+		&authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: verb, Resource: resource, Namespace: namespace},
+			},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,267 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/config"
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	// OIDC auth provider'ını client-go'ya kaydeder
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
+)
+
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+// execPluginTimeout plugin'in kimlik bilgisi üretmesi için beklenen en uzun süre
+const execPluginTimeout = 60 * time.Second
+
+// execCredential exec plugin çıktısının kullandığımız kısmı
+type execCredential struct {
+	Status *struct {
+		Token                 string     `json:"token"`
+		ClientCertificateData string     `json:"clientCertificateData"`
+		ExpirationTimestamp   *time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+}
+
+func connectWithExecPlugin() {
+	p := profileFromEnv(MethodExec)
+
+	if p.ServerURL == "" {
+		fmt.Print("Kubernetes API Server URL: ")
+		fmt.Scanf("%s", &p.ServerURL)
+		envManager.Set("API_SERVER", p.ServerURL)
+	}
+
+	if p.CACertPath == "" {
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+		envManager.Set("CA_CERT_PATH", p.CACertPath)
+	}
+
+	if p.ExecCommand == "" {
+		fmt.Print(i18n.T("Exec plugin komutu: "))
+		fmt.Scanf("%s", &p.ExecCommand)
+		envManager.Set("EXEC_COMMAND", p.ExecCommand)
+	}
+
+	if err := envManager.Save(); err != nil {
+		i18n.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
+	}
+
+	// Plugin'i önce kendimiz çalıştırıp hata durumunda stderr çıktısını göster
+	if err := runExecPlugin(p); err != nil {
+		i18n.Printf("Exec plugin hatası: %v\n", err)
+		return
+	}
+
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("Exec plugin ile bağlantı başarısız: %v\n", err)
+		return
+	}
+
+	fmt.Println(i18n.T("Exec plugin ile bağlantı başarılı! Token süresi dolduğunda plugin otomatik yeniden çalıştırılacak."))
+	waitForMainMenu()
+}
+
+func connectWithOIDC() {
+	p := profileFromEnv(MethodOIDC)
+
+	if p.ServerURL == "" {
+		fmt.Print("Kubernetes API Server URL: ")
+		fmt.Scanf("%s", &p.ServerURL)
+		envManager.Set("API_SERVER", p.ServerURL)
+	}
+
+	if p.CACertPath == "" {
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+		envManager.Set("CA_CERT_PATH", p.CACertPath)
+	}
+
+	if p.OIDCIssuerURL == "" {
+		fmt.Print("OIDC Issuer URL: ")
+		fmt.Scanf("%s", &p.OIDCIssuerURL)
+		envManager.Set("OIDC_ISSUER_URL", p.OIDCIssuerURL)
+	}
+
+	if p.OIDCClientID == "" {
+		fmt.Print("OIDC Client ID: ")
+		fmt.Scanf("%s", &p.OIDCClientID)
+		envManager.Set("OIDC_CLIENT_ID", p.OIDCClientID)
+	}
+
+	if p.OIDCRefreshToken == "" && p.OIDCIDToken == "" {
+		fmt.Print("OIDC Refresh Token: ")
+		fmt.Scanf("%s", &p.OIDCRefreshToken)
+		setSecret("OIDC_REFRESH_TOKEN", p.OIDCRefreshToken)
+	}
+
+	if err := envManager.Save(); err != nil {
+		i18n.Printf("Uyarı: .env dosyası kaydedilemedi: %v\n", err)
+	}
+
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("OIDC ile bağlantı başarısız: %v\n", err)
+		return
+	}
+
+	fmt.Println(i18n.T("OIDC ile bağlantı başarılı! ID token süresi dolduğunda refresh token ile yenilenecek."))
+	waitForMainMenu()
+}
+
+// execArgsFromEnv EXEC_ARGS değerini tırnakları dikkate alarak veya JSON
+// dizisi olarak böler. Ayrıştırılamayan değer uyarıyla boşluklardan bölünür.
+func execArgsFromEnv() []string {
+	value := envManager.Get("EXEC_ARGS")
+	args, err := config.SplitArgs(value)
+	if err != nil {
+		i18n.Fprintf(os.Stderr, "Uyarı: EXEC_ARGS ayrıştırılamadı (%v), boşluklardan bölünüyor\n", err)
+		return strings.Fields(value)
+	}
+	return args
+}
+
+// execProviderConfig profildeki exec plugin ayarlarından client-go exec config'i üretir
+func execProviderConfig(p Profile) *clientcmdapi.ExecConfig {
+	apiVersion := p.ExecAPIVersion
+	if apiVersion == "" {
+		apiVersion = defaultExecAPIVersion
+	}
+
+	env := make([]clientcmdapi.ExecEnvVar, 0, len(p.ExecEnv))
+	for _, kv := range p.ExecEnv {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			env = append(env, clientcmdapi.ExecEnvVar{Name: parts[0], Value: parts[1]})
+		}
+	}
+
+	return &clientcmdapi.ExecConfig{
+		APIVersion:      apiVersion,
+		Command:         p.ExecCommand,
+		Args:            p.ExecArgs,
+		Env:             env,
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+	}
+}
+
+// runExecPlugin plugin'i bir kez çalıştırır ve çıktının geçerli bir
+// ExecCredential olduğunu doğrular. Hata durumunda stderr içeriği döndürülür.
+func runExecPlugin(p Profile) error {
+	apiVersion := p.ExecAPIVersion
+	if apiVersion == "" {
+		apiVersion = defaultExecAPIVersion
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), execPluginTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, p.ExecCommand, p.ExecArgs...)
+	cmd.Env = append(os.Environ(), p.ExecEnv...)
+	cmd.Env = append(cmd.Env, fmt.Sprintf(
+		`KUBERNETES_EXEC_INFO={"apiVersion":"%s","kind":"ExecCredential","spec":{"interactive":false}}`, apiVersion))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Plugin'in başlattığı alt süreçler çıktıyı açık tutsa da beklemeyi sınırla
+	cmd.WaitDelay = 5 * time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return i18n.Errorf("plugin %v içinde yanıt vermedi (zaman aşımı)\n--- plugin stderr ---\n%s",
+				execPluginTimeout, strings.TrimSpace(stderr.String()))
+		}
+		return fmt.Errorf("%v\n--- plugin stderr ---\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var cred execCredential
+	if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+		return i18n.Errorf("plugin çıktısı ExecCredential değil: %v\n--- plugin stderr ---\n%s",
+			err, strings.TrimSpace(stderr.String()))
+	}
+	if cred.Status == nil || (cred.Status.Token == "" && cred.Status.ClientCertificateData == "") {
+		return i18n.Errorf("plugin token veya client sertifikası döndürmedi")
+	}
+
+	if cred.Status.ExpirationTimestamp != nil {
+		i18n.Printf("Plugin kimlik bilgisi geçerlilik sonu: %s\n",
+			cred.Status.ExpirationTimestamp.Local().Format("2006-01-02 15:04:05"))
+	}
+	return nil
+}
+
+// oidcAuthProvider profildeki OIDC ayarlarından auth provider config'i üretir
+func oidcAuthProvider(p Profile) *clientcmdapi.AuthProviderConfig {
+	cfg := map[string]string{
+		"idp-issuer-url": p.OIDCIssuerURL,
+		"client-id":      p.OIDCClientID,
+	}
+	if p.OIDCClientSecret != "" {
+		cfg["client-secret"] = p.OIDCClientSecret
+	}
+	if p.OIDCRefreshToken != "" {
+		cfg["refresh-token"] = p.OIDCRefreshToken
+	}
+	if p.OIDCIDToken != "" {
+		cfg["id-token"] = p.OIDCIDToken
+	}
+	return &clientcmdapi.AuthProviderConfig{Name: "oidc", Config: cfg}
+}
+
+// oidcPersister yenilenen OIDC token'larını bağlantının geldiği yere
+// (profil veya .env) geri yazar
+type oidcPersister struct {
+	profileName string
+}
+
+var _ rest.AuthProviderConfigPersister = oidcPersister{}
+
+func (op oidcPersister) Persist(cfg map[string]string) error {
+	idToken, refreshToken := cfg["id-token"], cfg["refresh-token"]
+
+	if op.profileName != "" {
+		p, exists := profileStore.Get(op.profileName)
+		if !exists {
+			return nil // Profil silinmişse yalnızca bellekteki token kullanılır
+		}
+		p.OIDCIDToken = idToken
+		if refreshToken != "" {
+			p.OIDCRefreshToken = refreshToken
+		}
+		profileStore.Set(p)
+		return profileStore.Save()
+	}
+
+	if err := envManager.Set("OIDC_ID_TOKEN", idToken); err != nil {
+		return err
+	}
+	if refreshToken != "" {
+		if err := envManager.Set("OIDC_REFRESH_TOKEN", refreshToken); err != nil {
+			return err
+		}
+	}
+	return envManager.Save()
+}
+
+// splitList virgülle ayrılmış değerleri boşlukları temizleyerek böler
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
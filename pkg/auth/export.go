@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// exportKubeconfig aktif bağlantıyı kubectl ile kullanılabilecek bir kubeconfig
+// olarak dosyaya yazar veya ~/.kube/config ile birleştirir
+func exportKubeconfig() {
+	if activeSession == nil {
+		fmt.Println(i18n.T("Hata: Önce bir bağlantı kurmalısınız!"))
+		return
+	}
+
+	fmt.Println(i18n.T("\n=== Kubeconfig Olarak Dışa Aktar ==="))
+	i18n.Printf("(Aktif Bağlantı: %s)\n\n", activeConnection)
+
+	reader := bufio.NewReader(os.Stdin)
+	name := promptDefault(reader, i18n.T("Cluster/kullanıcı/context adı"), defaultExportName())
+	embed := i18n.IsYes(promptDefault(reader, i18n.T("CA verisi dosyaya gömülsün mü? (e/h)"), i18n.T("e")))
+
+	exported, err := kubeconfigFromRestConfig(activeSession.Config, name, embed)
+	if err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+	exported.Clusters[name].ProxyURL = activeTransport.ProxyURL
+	if exported.AuthInfos[name].Token != "" {
+		fmt.Println(i18n.T("Uyarı: Dosya bearer token içerecek; paylaşırken dikkatli olun."))
+	}
+
+	fmt.Println(i18n.T("\n1. Yeni Dosyaya Yaz"))
+	i18n.Printf("2. %s ile Birleştir\n", clientcmd.RecommendedHomeFile)
+	switch promptDefault(reader, i18n.T("Seçiminiz (1-2)"), "1") {
+	case "1":
+		path := promptDefault(reader, i18n.T("Dosya yolu"), name+".kubeconfig")
+		if _, err := os.Stat(path); err == nil {
+			if !i18n.IsYes(promptDefault(reader, i18n.T("Dosya mevcut, üzerine yazılsın mı? (e/h)"), i18n.T("h"))) {
+				fmt.Println(i18n.T("İşlem iptal edildi."))
+				return
+			}
+		}
+		if err := writeKubeconfig(path, exported); err != nil {
+			i18n.Printf("Hata: %v\n", err)
+			return
+		}
+		i18n.Printf("Kubeconfig yazıldı: %s\n", path)
+		i18n.Printf("Kullanım: kubectl --kubeconfig %s get pods\n", path)
+	case "2":
+		mergeIntoHomeKubeconfig(reader, exported, name)
+	default:
+		fmt.Println(i18n.T("Geçersiz seçim!"))
+	}
+}
+
+// defaultExportName profil adını, yoksa API sunucusunun host adını döndürür
+func defaultExportName() string {
+	if activeSpec.Name != "" {
+		return activeSpec.Name
+	}
+	if activeSpec.Method == MethodKubeconfig && activeSpec.Context != "" {
+		return activeSpec.Context
+	}
+	if u, err := url.Parse(activeSession.Config.Host); err == nil && u.Hostname() != "" {
+		return "tamergoclient-" + u.Hostname()
+	}
+	return "tamergoclient"
+}
+
+// kubeconfigFromRestConfig rest config'deki sunucu, CA ve kimlik bilgilerini
+// tek cluster/user/context içeren bir kubeconfig'e dönüştürür
+func kubeconfigFromRestConfig(config *rest.Config, name string, embedCA bool) (*clientcmdapi.Config, error) {
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = config.Host
+	cluster.TLSServerName = config.ServerName
+	cluster.InsecureSkipTLSVerify = config.Insecure
+
+	caData := config.CAData
+	if embedCA && len(caData) == 0 && config.CAFile != "" {
+		data, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, i18n.Errorf("CA sertifikası okunamadı: %v", err)
+		}
+		caData = data
+	}
+	switch {
+	case embedCA || config.CAFile == "":
+		// Dosya yolu olmayan CA verisi ancak gömülerek taşınabilir
+		cluster.CertificateAuthorityData = caData
+	default:
+		path, err := filepath.Abs(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cluster.CertificateAuthority = path
+	}
+
+	user := clientcmdapi.NewAuthInfo()
+	user.Token = config.BearerToken
+	user.TokenFile = config.BearerTokenFile
+	user.ClientCertificate = config.CertFile
+	user.ClientCertificateData = config.CertData
+	user.ClientKey = config.KeyFile
+	user.ClientKeyData = config.KeyData
+	user.Username = config.Username
+	user.Password = config.Password
+	user.Impersonate = config.Impersonate.UserName
+	user.ImpersonateUID = config.Impersonate.UID
+	user.ImpersonateGroups = config.Impersonate.Groups
+	user.ImpersonateUserExtra = config.Impersonate.Extra
+	if config.ExecProvider != nil {
+		exec := *config.ExecProvider
+		user.Exec = &exec
+	}
+	if config.AuthProvider != nil {
+		provider := *config.AuthProvider
+		user.AuthProvider = &provider
+	}
+
+	if user.Token == "" && user.TokenFile == "" && len(user.ClientCertificateData) == 0 &&
+		user.ClientCertificate == "" && user.Exec == nil && user.AuthProvider == nil && user.Username == "" {
+		return nil, i18n.Errorf("aktif bağlantıda dışa aktarılabilecek kimlik bilgisi yok")
+	}
+
+	kubeContext := clientcmdapi.NewContext()
+	kubeContext.Cluster = name
+	kubeContext.AuthInfo = name
+
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters[name] = cluster
+	kubeconfig.AuthInfos[name] = user
+	kubeconfig.Contexts[name] = kubeContext
+	kubeconfig.CurrentContext = name
+	return kubeconfig, nil
+}
+
+// writeKubeconfig kubeconfig'i kimlik bilgisi içerdiği için 0600 izinle yazar
+func writeKubeconfig(path string, kubeconfig *clientcmdapi.Config) error {
+	data, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return i18n.Errorf("kubeconfig oluşturulamadı: %v", err)
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return i18n.Errorf("dizin oluşturulamadı: %v", err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return i18n.Errorf("kubeconfig yazılamadı: %v", err)
+	}
+	return nil
+}
+
+// mergeIntoHomeKubeconfig dışa aktarılan girdileri ~/.kube/config'e ekler,
+// aynı isimli girdiler için onay ister ve eski dosyanın yedeğini alır
+func mergeIntoHomeKubeconfig(reader *bufio.Reader, exported *clientcmdapi.Config, name string) {
+	path := clientcmd.RecommendedHomeFile
+
+	existing := clientcmdapi.NewConfig()
+	original, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if existing, err = clientcmd.Load(original); err != nil {
+			i18n.Printf("Hata: %s ayrıştırılamadı: %v\n", path, err)
+			return
+		}
+	case !os.IsNotExist(err):
+		i18n.Printf("Hata: %s okunamadı: %v\n", path, err)
+		return
+	}
+
+	_, clusterExists := existing.Clusters[name]
+	_, userExists := existing.AuthInfos[name]
+	_, contextExists := existing.Contexts[name]
+	if clusterExists || userExists || contextExists {
+		prompt := i18n.Sprintf("'%s' adlı girdiler zaten mevcut, üzerine yazılsın mı? (e/h)", name)
+		if !i18n.IsYes(promptDefault(reader, prompt, i18n.T("h"))) {
+			fmt.Println(i18n.T("İşlem iptal edildi."))
+			return
+		}
+	}
+
+	existing.Clusters[name] = exported.Clusters[name]
+	existing.AuthInfos[name] = exported.AuthInfos[name]
+	existing.Contexts[name] = exported.Contexts[name]
+	if existing.CurrentContext == "" ||
+		i18n.IsYes(promptDefault(reader, i18n.T("current-context bu context olarak ayarlansın mı? (e/h)"), i18n.T("h"))) {
+		existing.CurrentContext = name
+	}
+
+	if original != nil {
+		if err := os.WriteFile(path+".bak", original, 0600); err != nil {
+			i18n.Printf("Hata: Yedek alınamadı: %v\n", err)
+			return
+		}
+		i18n.Printf("Mevcut dosyanın yedeği alındı: %s.bak\n", path)
+	}
+	if err := writeKubeconfig(path, existing); err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+	i18n.Printf("'%s' context'i %s dosyasına eklendi.\n", name, path)
+	i18n.Printf("Kullanım: kubectl --context %s get pods\n", name)
+}
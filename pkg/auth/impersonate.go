@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+)
+
+var (
+	impersonation        rest.ImpersonationConfig // Bürünülecek kullanıcı, gruplar ve extra alanlar
+	impersonationEnabled bool                     // Ayarlar korunarak açılıp kapatılabilir
+)
+
+// impersonatedConfig temel config'in bürünme ayarları uygulanmış bir kopyasını döndürür
+func impersonatedConfig(base *rest.Config) *rest.Config {
+	config := rest.CopyConfig(base)
+	config.Impersonate = impersonation
+	return config
+}
+
+// applyImpersonation aktif oturumun client'larını yeniden kimlik doğrulama
+// yapmadan güncel bürünme ayarıyla yeniden oluşturur
+func applyImpersonation() error {
+	if activeSession == nil {
+		return nil // Bağlantı kurulduğunda uygulanır
+	}
+
+	config := activeBaseConfig
+	if impersonationEnabled {
+		config = impersonatedConfig(activeBaseConfig)
+	}
+	if err := activeSession.SetConfig(config); err != nil {
+		return i18n.Errorf("client oluşturulamadı: %v", err)
+	}
+	return nil
+}
+
+// GetImpersonation etkin bürünme ayarının menü başlığında gösterilecek
+// açıklamasını döndürür, bürünme kapalıysa boş string döner
+func GetImpersonation() string {
+	if !impersonationEnabled || impersonation.UserName == "" {
+		return ""
+	}
+	desc := impersonation.UserName
+	if len(impersonation.Groups) > 0 {
+		desc += i18n.Sprintf(" [gruplar: %s]", strings.Join(impersonation.Groups, ", "))
+	}
+	if len(impersonation.Extra) > 0 {
+		desc += i18n.Sprintf(" [extra: %d alan]", len(impersonation.Extra))
+	}
+	return desc
+}
+
+func showImpersonationMenu() int {
+	fmt.Println(i18n.T("\n=== Kimliğe Bürünme (Impersonation) ==="))
+	state := i18n.T("Kapalı")
+	if impersonationEnabled {
+		state = i18n.T("Açık")
+	}
+	i18n.Printf("Durum: %s\n", state)
+	user := impersonation.UserName
+	if user == "" {
+		user = i18n.T("<boş>")
+	}
+	i18n.Printf("Kullanıcı: %s\n", user)
+	if len(impersonation.Groups) > 0 {
+		i18n.Printf("Gruplar: %s\n", strings.Join(impersonation.Groups, ", "))
+	}
+	keys := make([]string, 0, len(impersonation.Extra))
+	for key := range impersonation.Extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("Extra %s: %s\n", key, strings.Join(impersonation.Extra[key], ", "))
+	}
+
+	fmt.Println(i18n.T("\n1. Kullanıcı Ayarla"))
+	fmt.Println(i18n.T("2. Service Account'a Bürün (namespace/isim)"))
+	fmt.Println(i18n.T("3. Grupları Ayarla"))
+	fmt.Println(i18n.T("4. Extra Alan Ekle"))
+	fmt.Println(i18n.T("5. Bürünmeyi Aç/Kapat"))
+	fmt.Println(i18n.T("6. Ayarları Temizle"))
+	fmt.Println(i18n.T("7. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-7): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+func handleImpersonationMenu() {
+	for {
+		choice := showImpersonationMenu()
+		reader := bufio.NewReader(os.Stdin)
+
+		switch choice {
+		case 1:
+			fmt.Print(i18n.T("Kullanıcı adı: "))
+			impersonation.UserName = readLine(reader)
+		case 2:
+			fmt.Print(i18n.T("Service Account (namespace/isim): "))
+			namespace, name, found := strings.Cut(readLine(reader), "/")
+			if !found || namespace == "" || name == "" {
+				fmt.Println(i18n.T("Hata: namespace/isim biçiminde girilmelidir!"))
+				continue
+			}
+			impersonation.UserName = fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+		case 3:
+			fmt.Print(i18n.T("Gruplar (virgülle ayrılmış, boş = temizle): "))
+			impersonation.Groups = splitList(readLine(reader))
+		case 4:
+			fmt.Print(i18n.T("Extra alan (anahtar=değer1,değer2): "))
+			key, values, found := strings.Cut(readLine(reader), "=")
+			if !found || strings.TrimSpace(key) == "" {
+				fmt.Println(i18n.T("Hata: anahtar=değer biçiminde girilmelidir!"))
+				continue
+			}
+			if impersonation.Extra == nil {
+				impersonation.Extra = make(map[string][]string)
+			}
+			impersonation.Extra[strings.TrimSpace(key)] = splitList(values)
+		case 5:
+			if !impersonationEnabled && impersonation.UserName == "" {
+				fmt.Println(i18n.T("Hata: Önce bürünülecek kullanıcıyı ayarlayın!"))
+				continue
+			}
+			impersonationEnabled = !impersonationEnabled
+		case 6:
+			impersonation = rest.ImpersonationConfig{}
+			impersonationEnabled = false
+		case 7:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+			continue
+		}
+
+		// Gruplar/extra alanlar kullanıcı olmadan gönderilemez
+		if impersonation.UserName == "" {
+			impersonationEnabled = false
+		}
+		if err := applyImpersonation(); err != nil {
+			i18n.Printf("Hata: Bürünme ayarı uygulanamadı: %v\n", err)
+			impersonationEnabled = false
+			continue
+		}
+		if desc := GetImpersonation(); desc != "" {
+			i18n.Printf("İstekler artık '%s' olarak gönderilecek.\n", desc)
+		} else {
+			fmt.Println(i18n.T("İstekler kendi kimliğinizle gönderilecek."))
+		}
+	}
+}
+
+func readLine(reader *bufio.Reader) string {
+	line, _ := reader.ReadString('\n')
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// inClusterNamespaceFile pod içinde çalışırken service account'un namespace'ini içeren dosya
+const inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// detectNamespace cluster genelinde yetki olmadığında kullanılacak namespace'i
+// sırasıyla yapılandırmadan, kubeconfig context'inden, service account
+// token'ından ve pod içindeki namespace dosyasından bulur
+func detectNamespace(p Profile, config *rest.Config) string {
+	if namespace := envManager.Get("K8S_NAMESPACE"); namespace != "" {
+		return namespace
+	}
+
+	if p.Method == MethodKubeconfig {
+		if kubeconfig, err := clientcmd.LoadFromFile(p.KubeconfigPath); err == nil {
+			if kubeContext, exists := kubeconfig.Contexts[p.Context]; exists && kubeContext.Namespace != "" {
+				return kubeContext.Namespace
+			}
+		}
+	}
+
+	if claims, err := decodeJWT(currentToken(config)); err == nil && claims.namespace() != "" {
+		return claims.namespace()
+	}
+
+	if data, err := os.ReadFile(inClusterNamespaceFile); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+	return ""
+}
+
+// GetNamespace ana menü başlığında gösterilecek aktif namespace'i döndürür,
+// bağlantı yoksa boş string döner
+func GetNamespace() string {
+	if activeSession == nil {
+		return ""
+	}
+	return activeSession.NamespaceLabel()
+}
+
+func showNamespaceMenu() int {
+	fmt.Println(i18n.T("\n=== Varsayılan Namespace ==="))
+	i18n.Printf("Aktif: %s\n", activeSession.NamespaceLabel())
+	if activeSession.FallbackNamespace != "" {
+		i18n.Printf("Yedek (cluster geneli yetki yoksa): %s\n", activeSession.FallbackNamespace)
+	}
+	fmt.Println(i18n.T("\n1. Listeden Seç"))
+	fmt.Println(i18n.T("2. İsim Girerek Ayarla"))
+	fmt.Println(i18n.T("3. Tüm Namespace'ler"))
+	fmt.Println(i18n.T("4. Seçimi Kalıcı Olarak Kaydet (K8S_NAMESPACE)"))
+	fmt.Println(i18n.T("5. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-5): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+// handleNamespaceMenu listelerin ve oluşturma işlemlerinin kullanacağı
+// varsayılan namespace'i seçtirir
+func handleNamespaceMenu() {
+	if activeSession == nil {
+		fmt.Println(i18n.T("Hata: Önce bir bağlantı kurmalısınız!"))
+		return
+	}
+
+	for {
+		choice := showNamespaceMenu()
+
+		switch choice {
+		case 1:
+			if namespace, ok := pickNamespace(); ok {
+				activeSession.Namespace = namespace
+				i18n.Printf("Varsayılan namespace: %s\n", namespace)
+			}
+		case 2:
+			fmt.Print("Namespace: ")
+			namespace := readLine(bufio.NewReader(os.Stdin))
+			if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+				i18n.Printf("Hata: Geçersiz namespace adı: %s\n", strings.Join(errs, "; "))
+				continue
+			}
+			activeSession.Namespace = namespace
+			i18n.Printf("Varsayılan namespace: %s\n", namespace)
+		case 3:
+			activeSession.Namespace = ""
+			fmt.Println(i18n.T("Listeler tüm namespace'leri kapsayacak."))
+		case 4:
+			envManager.Set("K8S_NAMESPACE", activeSession.Namespace)
+			if err := envManager.Save(); err != nil {
+				i18n.Printf("Hata: .env dosyası kaydedilemedi: %v\n", err)
+				continue
+			}
+			i18n.Printf("K8S_NAMESPACE=%s kaydedildi.\n", activeSession.Namespace)
+		case 5:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+		}
+	}
+}
+
+// pickNamespace namespace'leri numaralı liste olarak gösterip seçim yaptırır
+func pickNamespace() (string, bool) {
+	ctx, cancel := activeSession.Context()
+	defer cancel()
+
+	namespaces, err := activeSession.Client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if apierrors.IsForbidden(err) {
+		fmt.Println(i18n.T("Namespace listeleme yetkiniz yok; ismi '2. İsim Girerek Ayarla' ile girin."))
+		return "", false
+	}
+	if err != nil {
+		i18n.Printf("Hata: Namespace listesi alınamadı: %v\n", err)
+		return "", false
+	}
+
+	fmt.Println()
+	for i, ns := range namespaces.Items {
+		fmt.Printf("%d. %s\n", i+1, ns.Name)
+	}
+	fmt.Print(i18n.T("Namespace numarası (0 için iptal): "))
+	var choice int
+	fmt.Scanf("%d", &choice)
+	if choice < 1 || choice > len(namespaces.Items) {
+		return "", false
+	}
+	return namespaces.Items[choice-1].Name, true
+}
@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/restmapper"
+)
+
+// matrixVerbs yetki matrisinde sütun olarak gösterilen fiiller
+var matrixVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection"}
+
+func showPermissionMenu() int {
+	fmt.Println(i18n.T("\n=== Yetki İnceleme ==="))
+	i18n.Printf("(Aktif Bağlantı: %s)\n", activeConnection)
+	fmt.Println(i18n.T("1. Namespace Yetki Matrisini Göster"))
+	fmt.Println(i18n.T("2. \"Yapabilir miyim?\" Sorusu Sor"))
+	fmt.Println(i18n.T("3. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-3): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+func handlePermissionMenu() {
+	if activeSession == nil {
+		fmt.Println(i18n.T("Hata: Önce bir bağlantı kurmalısınız!"))
+		return
+	}
+
+	for {
+		choice := showPermissionMenu()
+
+		switch choice {
+		case 1:
+			showPermissionMatrix()
+		case 2:
+			askCanI()
+		case 3:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+		}
+	}
+}
+
+// showPermissionMatrix SelfSubjectRulesReview sonucunu kaynak x fiil tablosu
+// olarak gösterir
+func showPermissionMatrix() {
+	fmt.Print(i18n.T("Namespace (varsayılan: default): "))
+	var namespace string
+	fmt.Scanf("%s", &namespace)
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	review, err := activeSession.Client.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx,
+		&authorizationv1.SelfSubjectRulesReview{
+			Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		i18n.Printf("Hata: Yetki kuralları alınamadı: %v\n", err)
+		return
+	}
+
+	// Satır: "kaynak.grup", değer: izin verilen fiiller (sadece belirli isimlerle
+	// sınırlıysa false)
+	matrix := make(map[string]map[string]bool)
+	for _, rule := range review.Status.ResourceRules {
+		limited := len(rule.ResourceNames) > 0
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				row := resource
+				if group != "" {
+					row = resource + "." + group
+				}
+				if matrix[row] == nil {
+					matrix[row] = make(map[string]bool)
+				}
+				for _, verb := range rule.Verbs {
+					verbs := []string{verb}
+					if verb == "*" {
+						verbs = matrixVerbs
+					}
+					for _, v := range verbs {
+						// Sınırsız izin, isimle sınırlı izni ezer
+						if full, exists := matrix[row][v]; !exists || !full {
+							matrix[row][v] = !limited
+						}
+					}
+				}
+			}
+		}
+	}
+
+	rows := make([]string, 0, len(matrix))
+	for row := range matrix {
+		rows = append(rows, row)
+	}
+	sort.Strings(rows)
+
+	i18n.Printf("\n'%s' namespace'i için yetkiler (✓: izinli, ~: yalnızca belirli isimler):\n\n", namespace)
+	fmt.Printf("%-45s", i18n.T("KAYNAK"))
+	for _, verb := range matrixVerbs {
+		fmt.Printf(" %-6s", abbreviateVerb(verb))
+	}
+	fmt.Println()
+	fmt.Println(strings.Repeat("-", 45+7*len(matrixVerbs)))
+
+	for _, row := range rows {
+		fmt.Printf("%-45s", row)
+		for _, verb := range matrixVerbs {
+			mark := "-"
+			if full, exists := matrix[row][verb]; exists {
+				mark = "✓"
+				if !full {
+					mark = "~"
+				}
+			}
+			fmt.Printf(" %-6s", mark)
+		}
+		fmt.Println()
+	}
+
+	if len(review.Status.NonResourceRules) > 0 {
+		fmt.Println(i18n.T("\nKaynak dışı URL yetkileri:"))
+		for _, rule := range review.Status.NonResourceRules {
+			fmt.Printf("  %s: %s\n", strings.Join(rule.Verbs, ","), strings.Join(rule.NonResourceURLs, ", "))
+		}
+	}
+
+	if review.Status.Incomplete {
+		fmt.Println(i18n.T("\nUyarı: API sunucusu kural listesinin eksik olabileceğini bildirdi."))
+		if review.Status.EvaluationError != "" {
+			i18n.Printf("Değerlendirme hatası: %s\n", review.Status.EvaluationError)
+		}
+	}
+}
+
+// abbreviateVerb tablo sütunlarını dar tutmak için uzun fiilleri kısaltır
+func abbreviateVerb(verb string) string {
+	if verb == "deletecollection" {
+		return "delcol"
+	}
+	return verb
+}
+
+// askCanI "delete deployments client-access" gibi bir soruyu
+// SelfSubjectAccessReview ile API sunucusuna sorar
+func askCanI() {
+	fmt.Println(i18n.T("\nSoru biçimi: <fiil> <kaynak>[/altkaynak][.grup] [namespace]"))
+	fmt.Println(i18n.T("Örnekler: delete deployments client-access | get pods/log default | list nodes"))
+	fmt.Print(i18n.T("Soru: "))
+
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return
+	}
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(scanner.Text()), "?"))
+	if len(fields) < 2 || len(fields) > 3 {
+		fmt.Println(i18n.T("Hata: Fiil ve kaynak belirtilmelidir!"))
+		return
+	}
+
+	attrs := &authorizationv1.ResourceAttributes{Verb: fields[0]}
+	resource := fields[1]
+	if name, sub, found := strings.Cut(resource, "/"); found {
+		resource, attrs.Subresource = name, sub
+	}
+	if len(fields) == 3 {
+		attrs.Namespace = fields[2]
+	}
+
+	gvr, err := resolveResource(resource)
+	if err != nil {
+		i18n.Printf("Uyarı: Kaynak grubu bulunamadı, core grup varsayılıyor: %v\n", err)
+		gvr = schema.GroupVersionResource{Resource: resource}
+	}
+	attrs.Group, attrs.Resource = gvr.Group, gvr.Resource
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	review, err := activeSession.Client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx,
+		&authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attrs},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		i18n.Printf("Hata: Yetki sorgulanamadı: %v\n", err)
+		return
+	}
+
+	scope := i18n.T("tüm namespace'lerde")
+	if attrs.Namespace != "" {
+		scope = i18n.Sprintf("'%s' namespace'inde", attrs.Namespace)
+	}
+	target := gvr.GroupResource().String()
+	if attrs.Subresource != "" {
+		target += "/" + attrs.Subresource
+	}
+
+	if review.Status.Allowed {
+		i18n.Printf("EVET: %s %s için '%s' yetkiniz var.\n", scope, target, attrs.Verb)
+	} else {
+		i18n.Printf("HAYIR: %s %s için '%s' yetkiniz yok.\n", scope, target, attrs.Verb)
+	}
+	if review.Status.Reason != "" {
+		i18n.Printf("Sebep: %s\n", review.Status.Reason)
+	}
+	if review.Status.EvaluationError != "" {
+		i18n.Printf("Değerlendirme hatası: %s\n", review.Status.EvaluationError)
+	}
+}
+
+// resolveResource "deployments" veya "deploy.apps" gibi bir kaynak adını
+// discovery bilgisiyle tam grup/kaynak adına çevirir
+func resolveResource(resource string) (schema.GroupVersionResource, error) {
+	groupResources, err := restmapper.GetAPIGroupResources(activeSession.Client.Discovery())
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	mapper := restmapper.NewShortcutExpander(restmapper.NewDiscoveryRESTMapper(groupResources), activeSession.Client.Discovery(), nil)
+	return mapper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+}
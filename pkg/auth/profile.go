@@ -0,0 +1,422 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"tamerGoClient/pkg/config"
+	"tamerGoClient/pkg/i18n"
+)
+
+// profilesFileName profil dosyasının .env dosyasıyla aynı dizindeki adı
+const profilesFileName = ".profiles.json"
+
+// Profile kaydedilmiş, isimlendirilmiş bir bağlantı tanımıdır
+type Profile struct {
+	Name           string `json:"name"`
+	Method         string `json:"method"`
+	ServerURL      string `json:"serverURL,omitempty"`
+	Token          string `json:"token,omitempty"`
+	TokenFile      string `json:"tokenFile,omitempty"`
+	CACertPath     string `json:"caCertPath,omitempty"`
+	CACertData     string `json:"caCertData,omitempty"`
+	ClientCertPath string `json:"clientCertPath,omitempty"`
+	ClientCertData string `json:"clientCertData,omitempty"`
+	ClientKeyPath  string `json:"clientKeyPath,omitempty"`
+	ClientKeyData  string `json:"clientKeyData,omitempty"`
+	KubeconfigPath string `json:"kubeconfigPath,omitempty"`
+	Context        string `json:"context,omitempty"`
+
+	ExecCommand    string   `json:"execCommand,omitempty"`
+	ExecArgs       []string `json:"execArgs,omitempty"`
+	ExecEnv        []string `json:"execEnv,omitempty"`
+	ExecAPIVersion string   `json:"execAPIVersion,omitempty"`
+
+	OIDCIssuerURL    string `json:"oidcIssuerURL,omitempty"`
+	OIDCClientID     string `json:"oidcClientID,omitempty"`
+	OIDCClientSecret string `json:"oidcClientSecret,omitempty"`
+	OIDCRefreshToken string `json:"oidcRefreshToken,omitempty"`
+	OIDCIDToken      string `json:"oidcIDToken,omitempty"`
+
+	// SecretRefs gizli değerlerin (token, anahtar vb.) .env veya kasadaki
+	// anahtarlarını tutar; değerlerin kendisi profil dosyasına yazılmaz.
+	// Eski dosyalardaki düz metin değerler okunur ve ilk kayıtta taşınır.
+	SecretRefs map[string]string `json:"secretRefs,omitempty"`
+}
+
+// secretFields gizli alanları saklandıkları .env anahtarıyla eşler
+func (p *Profile) secretFields() map[string]*string {
+	return map[string]*string{
+		"K8S_TOKEN":          &p.Token,
+		"CLIENT_KEY_DATA":    &p.ClientKeyData,
+		"OIDC_CLIENT_SECRET": &p.OIDCClientSecret,
+		"OIDC_REFRESH_TOKEN": &p.OIDCRefreshToken,
+		"OIDC_ID_TOKEN":      &p.OIDCIDToken,
+	}
+}
+
+// ProfileStore profilleri diskteki JSON dosyasında, gizli değerlerini ise
+// secrets üzerinden .env'de veya açıksa şifreli kasada saklar
+type ProfileStore struct {
+	filePath      string
+	profiles      map[string]Profile
+	secrets       *config.EnvManager
+	inlineSecrets bool // Dosyada düz metin gizli değer bulundu, Save taşır
+}
+
+var (
+	profileStore *ProfileStore
+	activeSpec   Profile // Aktif bağlantının profil karşılığı (isimsiz olabilir)
+)
+
+func NewProfileStore(filePath string, secrets *config.EnvManager) *ProfileStore {
+	return &ProfileStore{
+		filePath: filePath,
+		profiles: make(map[string]Profile),
+		secrets:  secrets,
+	}
+}
+
+func (ps *ProfileStore) Load() error {
+	data, err := os.ReadFile(ps.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // Dosya yoksa boş liste ile devam et
+		}
+		return err
+	}
+
+	var profiles []Profile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return i18n.Errorf("profil dosyası ayrıştırılamadı: %v", err)
+	}
+	for _, p := range profiles {
+		for key, value := range p.secretFields() {
+			if *value != "" {
+				ps.inlineSecrets = true
+			} else if ref, exists := p.SecretRefs[key]; exists {
+				*value = ps.secrets.Get(ref)
+			}
+		}
+		ps.profiles[p.Name] = p
+	}
+	return nil
+}
+
+// Save gizli değerleri profil dosyasına referans olarak yazar; değerler
+// .env'e veya açıksa kasaya kaydedilir. Kasa dosyası varken kilitliyse
+// hiçbir şey yazılmadan hata döner.
+func (ps *ProfileStore) Save() error {
+	var stored []Profile
+	for _, p := range ps.List() {
+		refs := make(map[string]string)
+		for key, value := range p.secretFields() {
+			ref := config.ProfileSecretKey(p.Name, key)
+			switch {
+			case *value != "":
+				if err := ps.secrets.Set(ref, *value); err != nil {
+					return i18n.Errorf("'%s' profilinin %s değeri kaydedilemedi: %v", p.Name, key, err)
+				}
+				refs[key] = ref
+			case p.SecretRefs[key] == ref:
+				refs[key] = ref // Değer okunamadıysa (kasa kilitli) referans korunur
+			}
+		}
+		if len(refs) == 0 {
+			refs = nil
+		}
+		p.SecretRefs = refs
+		ps.profiles[p.Name] = p
+
+		for _, value := range p.secretFields() {
+			*value = ""
+		}
+		stored = append(stored, p)
+	}
+	if err := ps.secrets.Save(); err != nil {
+		return err
+	}
+	ps.inlineSecrets = false
+
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ps.filePath), 0700); err != nil {
+		return err
+	}
+	// Token içerebileceği için dosya sadece sahibi tarafından okunabilir
+	return os.WriteFile(ps.filePath, data, 0600)
+}
+
+func (ps *ProfileStore) Get(name string) (Profile, bool) {
+	p, exists := ps.profiles[name]
+	return p, exists
+}
+
+func (ps *ProfileStore) Set(p Profile) {
+	ps.profiles[p.Name] = p
+}
+
+// Delete profili ve .env/kasadaki gizli değerlerini kaldırır
+func (ps *ProfileStore) Delete(name string) {
+	for _, ref := range ps.profiles[name].SecretRefs {
+		ps.secrets.Unset(ref)
+	}
+	delete(ps.profiles, name)
+}
+
+// List profilleri isme göre sıralı döndürür
+func (ps *ProfileStore) List() []Profile {
+	profiles := make([]Profile, 0, len(ps.profiles))
+	for _, p := range ps.profiles {
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles
+}
+
+func showProfileMenu() int {
+	fmt.Println(i18n.T("\n=== Bağlantı Profilleri ==="))
+	if activeSpec.Name != "" {
+		i18n.Printf("(Aktif Profil: %s)\n", activeSpec.Name)
+	}
+	fmt.Println(i18n.T("1. Profilleri Listele"))
+	fmt.Println(i18n.T("2. Profile Geç"))
+	fmt.Println(i18n.T("3. Yeni Profil Ekle"))
+	fmt.Println(i18n.T("4. Aktif Bağlantıyı Profil Olarak Kaydet"))
+	fmt.Println(i18n.T("5. Profil Sil"))
+	fmt.Println(i18n.T("6. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-6): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+	return choice
+}
+
+func handleProfileMenu() {
+	for {
+		choice := showProfileMenu()
+
+		switch choice {
+		case 1:
+			listProfiles()
+		case 2:
+			if switchProfile() {
+				return // Profil değiştiğinde ana menüye dön
+			}
+		case 3:
+			addProfile()
+		case 4:
+			saveActiveAsProfile()
+		case 5:
+			deleteProfile()
+		case 6:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+		}
+	}
+}
+
+func listProfiles() []Profile {
+	profiles := profileStore.List()
+	if len(profiles) == 0 {
+		fmt.Println(i18n.T("\nHenüz kayıtlı profil yok."))
+		return profiles
+	}
+
+	fmt.Println(i18n.T("\nKayıtlı Profiller:"))
+	fmt.Printf("%-5s %-20s %-15s %-50s\n", "NO", i18n.T("İSİM"), i18n.T("YÖNTEM"), i18n.T("HEDEF"))
+	for i, p := range profiles {
+		marker := ""
+		if p.Name == activeSpec.Name {
+			marker = " (aktif)"
+		}
+		fmt.Printf("%-5d %-20s %-15s %-50s%s\n", i+1, p.Name, p.Method, profileTarget(p), marker)
+	}
+	return profiles
+}
+
+// profileTarget profilin bağlandığı hedefi okunabilir biçimde döndürür
+func profileTarget(p Profile) string {
+	switch p.Method {
+	case MethodServiceAccount, MethodClientCert, MethodExec, MethodOIDC:
+		return p.ServerURL
+	case MethodKubeconfig:
+		return fmt.Sprintf("%s (%s)", p.Context, p.KubeconfigPath)
+	default:
+		return "-"
+	}
+}
+
+// selectProfile profilleri listeler ve kullanıcının seçtiği profili döndürür
+func selectProfile(prompt string) (Profile, bool) {
+	profiles := listProfiles()
+	if len(profiles) == 0 {
+		return Profile{}, false
+	}
+
+	i18n.Printf("\n%s (0 için iptal): ", prompt)
+	var choice int
+	fmt.Scanf("%d", &choice)
+
+	if choice < 1 || choice > len(profiles) {
+		return Profile{}, false
+	}
+	return profiles[choice-1], true
+}
+
+func switchProfile() bool {
+	p, ok := selectProfile(i18n.T("Geçilecek profilin numarası"))
+	if !ok {
+		return false
+	}
+
+	if err := connectProfile(p); err != nil {
+		i18n.Printf("'%s' profiline bağlanılamadı: %v\n", p.Name, err)
+		return false
+	}
+
+	i18n.Printf("'%s' profiline geçildi: %s\n", p.Name, activeConnection)
+	waitForMainMenu()
+	return true
+}
+
+func addProfile() {
+	var p Profile
+	fmt.Print(i18n.T("\nProfil adı: "))
+	fmt.Scanf("%s", &p.Name)
+	if p.Name == "" {
+		fmt.Println(i18n.T("Profil adı boş olamaz!"))
+		return
+	}
+	if _, exists := profileStore.Get(p.Name); exists {
+		i18n.Printf("'%s' adında bir profil zaten var.\n", p.Name)
+		return
+	}
+
+	fmt.Println(i18n.T("\nKimlik doğrulama yöntemi:"))
+	fmt.Println("1. Service Account")
+	fmt.Println("2. Kubeconfig Context")
+	fmt.Println("3. In-Cluster")
+	fmt.Println(i18n.T("4. Client Sertifikası (mTLS)"))
+	fmt.Print(i18n.T("Seçiminiz (1-4): "))
+	var choice int
+	fmt.Scanf("%d", &choice)
+
+	switch choice {
+	case 1:
+		p.Method = MethodServiceAccount
+		fmt.Print("Kubernetes API Server URL: ")
+		fmt.Scanf("%s", &p.ServerURL)
+		fmt.Print("Service Account Token: ")
+		fmt.Scanf("%s", &p.Token)
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+	case 2:
+		p.Method = MethodKubeconfig
+		p.KubeconfigPath = resolveKubeconfigPath()
+		kubeconfig, err := loadKubeconfig(p.KubeconfigPath)
+		if err != nil {
+			i18n.Printf("Hata: %v\n", err)
+			return
+		}
+		contextName, err := selectKubeconfigContext(kubeconfig)
+		if err != nil {
+			i18n.Printf("Hata: %v\n", err)
+			return
+		}
+		p.Context = contextName
+	case 3:
+		p.Method = MethodInCluster
+	case 4:
+		p.Method = MethodClientCert
+		fmt.Print("Kubernetes API Server URL: ")
+		fmt.Scanf("%s", &p.ServerURL)
+		fmt.Print(i18n.T("Client sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.ClientCertPath)
+		fmt.Print(i18n.T("Client anahtar dosya yolu: "))
+		fmt.Scanf("%s", &p.ClientKeyPath)
+		fmt.Print(i18n.T("CA Sertifika dosya yolu: "))
+		fmt.Scanf("%s", &p.CACertPath)
+	default:
+		fmt.Println(i18n.T("Geçersiz seçim!"))
+		return
+	}
+
+	profileStore.Set(p)
+	if err := profileStore.Save(); err != nil {
+		i18n.Printf("Hata: Profil kaydedilemedi: %v\n", err)
+		return
+	}
+	i18n.Printf("'%s' profili kaydedildi.\n", p.Name)
+}
+
+func saveActiveAsProfile() {
+	if activeSession == nil || activeSpec.Method == "" {
+		fmt.Println(i18n.T("\nUyarı: Kaydedilecek aktif bir bağlantı yok!"))
+		return
+	}
+
+	fmt.Print(i18n.T("\nProfil adı: "))
+	var name string
+	fmt.Scanf("%s", &name)
+	if name == "" {
+		fmt.Println(i18n.T("Profil adı boş olamaz!"))
+		return
+	}
+
+	if _, exists := profileStore.Get(name); exists {
+		i18n.Printf("'%s' profili zaten var, üzerine yazılsın mı? [e/h]: ", name)
+		var confirm string
+		fmt.Scanf("%s", &confirm)
+		if !i18n.IsYes(confirm) {
+			return
+		}
+	}
+
+	p := activeSpec
+	p.Name = name
+	profileStore.Set(p)
+	if err := profileStore.Save(); err != nil {
+		i18n.Printf("Hata: Profil kaydedilemedi: %v\n", err)
+		return
+	}
+	activeSpec.Name = name
+	i18n.Printf("Aktif bağlantı '%s' profili olarak kaydedildi.\n", name)
+}
+
+func deleteProfile() {
+	p, ok := selectProfile(i18n.T("Silinecek profilin numarası"))
+	if !ok {
+		return
+	}
+
+	i18n.Printf("'%s' profilini silmek istediğinizden emin misiniz? [e/h]: ", p.Name)
+	var confirm string
+	fmt.Scanf("%s", &confirm)
+	if !i18n.IsYes(confirm) {
+		return
+	}
+
+	profileStore.Delete(p.Name)
+	if err := profileStore.Save(); err != nil {
+		i18n.Printf("Hata: Profil silinemedi: %v\n", err)
+		return
+	}
+	if activeSpec.Name == p.Name {
+		activeSpec.Name = "" // Bağlantı açık kalır ama artık bir profile ait değil
+	}
+	i18n.Printf("'%s' profili silindi.\n", p.Name)
+}
+
+// GetActiveProfile aktif bağlantının profil adını döndürür (profil yoksa boş)
+func GetActiveProfile() string {
+	return activeSpec.Name
+}
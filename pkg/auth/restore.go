@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"tamerGoClient/pkg/config"
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// lastConnectionFile son başarılı bağlantının kaydedildiği dosya (Init'te .env'in yanına ayarlanır)
+var lastConnectionFile = ".last-connection.json"
+
+// lastConnection son başarılı bağlantının nasıl yeniden kurulacağını tutar.
+// Gizli bilgi içermez; token ve anahtarlar .env, kasa veya profil dosyasından okunur.
+type lastConnection struct {
+	Method         string `json:"method"`
+	Profile        string `json:"profile,omitempty"`
+	KubeconfigPath string `json:"kubeconfigPath,omitempty"`
+	Context        string `json:"context,omitempty"`
+}
+
+// rememberConnection bağlantıyı bir sonraki açılışta geri yüklenmek üzere kaydeder
+func rememberConnection(p Profile) error {
+	last := lastConnection{Method: p.Method, Profile: p.Name}
+	if p.Name == "" && p.Method == MethodKubeconfig {
+		last.KubeconfigPath = p.KubeconfigPath
+		last.Context = p.Context
+	}
+
+	data, err := json.MarshalIndent(last, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(lastConnectionFile), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(lastConnectionFile, data, 0600)
+}
+
+// loadLastConnection kayıtlı son bağlantıyı okur, kayıt yoksa nil döner
+func loadLastConnection() (*lastConnection, error) {
+	data, err := os.ReadFile(lastConnectionFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var last lastConnection
+	if err := json.Unmarshal(data, &last); err != nil {
+		return nil, i18n.Errorf("son bağlantı dosyası ayrıştırılamadı: %v", err)
+	}
+	return &last, nil
+}
+
+// profileFor kayıttan bağlanılacak profili oluşturur
+func (last *lastConnection) profileFor() (Profile, error) {
+	if last.Profile != "" {
+		p, exists := profileStore.Get(last.Profile)
+		if !exists {
+			return Profile{}, i18n.Errorf("'%s' profili artık mevcut değil", last.Profile)
+		}
+		return p, nil
+	}
+
+	switch last.Method {
+	case MethodKubeconfig:
+		return Profile{Method: MethodKubeconfig, KubeconfigPath: last.KubeconfigPath, Context: last.Context}, nil
+	case MethodInCluster:
+		return Profile{Method: MethodInCluster}, nil
+	case MethodServiceAccount, MethodClientCert, MethodExec, MethodOIDC:
+		return profileFromEnv(last.Method), nil
+	default:
+		return Profile{}, i18n.Errorf("bilinmeyen bağlantı yöntemi: %s", last.Method)
+	}
+}
+
+func (last *lastConnection) String() string {
+	if last.Profile != "" {
+		return i18n.Sprintf("%s profili", last.Profile)
+	}
+	if last.Method == MethodKubeconfig {
+		return fmt.Sprintf("Kubeconfig (%s)", last.Context)
+	}
+	return last.Method
+}
+
+// RestoreLastConnection açılışta son başarılı bağlantıyı yeniden kurmayı dener.
+// Kayıt yoksa (false, nil), bağlantı kurulamazsa hata döner.
+func RestoreLastConnection() (bool, error) {
+	last, err := loadLastConnection()
+	if err != nil || last == nil {
+		return false, err
+	}
+
+	i18n.Fprintf(os.Stderr, "\n>>> Son bağlantı geri yükleniyor: %s (atlamak için --no-restore)\n", last)
+	p, err := last.profileFor()
+	if err == nil {
+		err = connectProfile(p)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	i18n.Fprintf(os.Stderr, ">>> Bağlandı: %s\n", activeConnection)
+	warnTokenExpiry()
+	return true, nil
+}
+
+// ConnectNonInteractive komut satırı alt komutları için kullanıcıya soru
+// sormadan bağlanır. Komut satırı veya ortam değişkeniyle açıkça verilen
+// kubeconfig ya da API sunucusu son başarılı bağlantıdan önce gelir; ardından
+// son bağlantı, yapılandırmadaki kubeconfig (geçerli context), Service Account
+// bilgileri ve pod içi yapılandırma denenir. Tek seferlik komutlar menünün
+// geri yükleyeceği son bağlantıyı değiştirmez.
+func ConnectNonInteractive() (*session.Session, error) {
+	p, err := nonInteractiveProfile()
+	if err != nil {
+		return nil, err
+	}
+	if err := connectSession(p); err != nil {
+		return nil, err
+	}
+	return activeSession, nil
+}
+
+func nonInteractiveProfile() (Profile, error) {
+	if explicitlySet("KUBECONFIG_PATH") {
+		return currentContextProfile(envManager.Get("KUBECONFIG_PATH"))
+	}
+	if explicitlySet("API_SERVER") {
+		p := profileFromEnv(MethodServiceAccount)
+		if !serviceAccountComplete(p) {
+			return Profile{}, errors.New(i18n.T("API_SERVER verildi ancak K8S_TOKEN/K8S_TOKEN_FILE veya CA_CERT_PATH eksik"))
+		}
+		return p, nil
+	}
+
+	last, err := loadLastConnection()
+	if err != nil {
+		return Profile{}, err
+	}
+	if last != nil {
+		return last.profileFor()
+	}
+
+	if path := envManager.Get("KUBECONFIG_PATH"); path != "" {
+		return currentContextProfile(path)
+	}
+
+	p := profileFromEnv(MethodServiceAccount)
+	if serviceAccountComplete(p) {
+		return p, nil
+	}
+
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		return Profile{Method: MethodInCluster}, nil
+	}
+	return Profile{}, i18n.Errorf("bağlantı bilgisi bulunamadı: önce menüden bağlanın ya da KUBECONFIG_PATH veya API_SERVER/K8S_TOKEN/CA_CERT_PATH tanımlayın")
+}
+
+// explicitlySet anahtarın komut satırı (--kubeconfig, --api-server, --set)
+// veya ortam değişkeniyle verilip verilmediğini döndürür
+func explicitlySet(key string) bool {
+	value, source := envManager.Lookup(key)
+	return value != "" && source >= config.SourceEnv
+}
+
+// currentContextProfile kubeconfig dosyasının geçerli context'i için profil döndürür
+func currentContextProfile(path string) (Profile, error) {
+	kubeconfig, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return Profile{}, i18n.Errorf("kubeconfig yüklenemedi: %v", err)
+	}
+	if kubeconfig.CurrentContext == "" {
+		return Profile{}, i18n.Errorf("%s dosyasında geçerli context tanımlı değil", path)
+	}
+	return Profile{Method: MethodKubeconfig, KubeconfigPath: path, Context: kubeconfig.CurrentContext}, nil
+}
+
+func serviceAccountComplete(p Profile) bool {
+	return p.ServerURL != "" && (p.Token != "" || p.TokenFile != "") && p.CACertPath != ""
+}
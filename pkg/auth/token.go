@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+)
+
+// tokenExpiryWarningWindow token süresinin dolmasına bu kadar kala uyarı verilir
+const tokenExpiryWarningWindow = 10 * time.Minute
+
+var (
+	activeToken string     // Aktif bağlantının bearer token'ı (varsa)
+	reauthMu    sync.Mutex // Aynı anda birden fazla yeniden bağlanmayı engeller
+)
+
+// jwtClaims token'dan okuduğumuz alanlar
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Issuer    string `json:"iss"`
+	ExpiresAt int64  `json:"exp"`
+	IssuedAt  int64  `json:"iat"`
+
+	// Service account token'larında namespace (bound ve eski biçim)
+	Kubernetes struct {
+		Namespace string `json:"namespace"`
+	} `json:"kubernetes.io"`
+	LegacyNamespace string `json:"kubernetes.io/serviceaccount/namespace"`
+}
+
+// decodeJWT imza doğrulaması yapmadan JWT payload'ını çözer
+func decodeJWT(token string) (*jwtClaims, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, i18n.Errorf("token JWT formatında değil (%d parça)", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, i18n.Errorf("JWT payload çözülemedi: %v", err)
+	}
+
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, i18n.Errorf("JWT payload ayrıştırılamadı: %v", err)
+	}
+	return &claims, nil
+}
+
+// namespace service account token'ının ait olduğu namespace'i döndürür
+func (c *jwtClaims) namespace() string {
+	if c.Kubernetes.Namespace != "" {
+		return c.Kubernetes.Namespace
+	}
+	return c.LegacyNamespace
+}
+
+// tokenExpiry token JWT ise ve exp alanı varsa sona erme zamanını döndürür
+func tokenExpiry(token string) (time.Time, bool) {
+	claims, err := decodeJWT(token)
+	if err != nil || claims.ExpiresAt == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.ExpiresAt, 0), true
+}
+
+// TokenExpiryWarning aktif token'ın süresi dolmuşsa veya dolmak üzereyse
+// gösterilecek uyarıyı döndürür, aksi halde boş string döner
+func TokenExpiryWarning() string {
+	if activeToken == "" {
+		return ""
+	}
+	expiry, ok := tokenExpiry(activeToken)
+	if !ok {
+		return ""
+	}
+
+	remaining := time.Until(expiry)
+	switch {
+	case remaining <= 0:
+		return i18n.Sprintf("Token süresi doldu (%s)! İlk istekte yeniden bağlanılmaya çalışılacak.",
+			expiry.Local().Format("2006-01-02 15:04:05"))
+	case remaining < tokenExpiryWarningWindow:
+		return i18n.Sprintf("Token %s içinde sona erecek (%s).",
+			remaining.Round(time.Second), expiry.Local().Format("15:04:05"))
+	}
+	return ""
+}
+
+// currentToken config'deki bearer token'ı, token dosyası varsa dosyadaki
+// güncel değeri döndürür
+func currentToken(config *rest.Config) string {
+	if config.BearerTokenFile != "" {
+		if data, err := os.ReadFile(config.BearerTokenFile); err == nil {
+			return strings.TrimSpace(string(data))
+		}
+	}
+	return config.BearerToken
+}
+
+// reauthRoundTripper Unauthorized yanıtlarında kimlik bilgilerini yeniden
+// yükleyip client'ı yeniden oluşturur ve isteği yeni token ile bir kez tekrarlar
+type reauthRoundTripper struct {
+	next   http.RoundTripper
+	active *atomic.Bool // Bağlantı kurulduktan sonra etkinleşir
+}
+
+func (rt *reauthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !rt.active.Load() {
+		return resp, err
+	}
+
+	// Gövdesi tekrar okunamayan istekleri yeniden denemeyiz
+	if req.Body != nil && req.GetBody == nil {
+		return resp, err
+	}
+
+	// Bildirimler komut satırı çıktısını (-o json vb.) bozmamak için stderr'e yazılır
+	fmt.Fprintln(os.Stderr, i18n.T("\nUyarı: API sunucusu Unauthorized döndürdü, kimlik bilgileri yenileniyor..."))
+	token, reauthErr := reauthenticate()
+	if reauthErr != nil {
+		i18n.Fprintf(os.Stderr, "Yeniden bağlanılamadı: %v\n", reauthErr)
+		return resp, err
+	}
+	i18n.Fprintf(os.Stderr, "Yeniden bağlanıldı: %s\n", activeConnection)
+	if token == "" {
+		return resp, err // Token dışı yöntemlerde yeni client bir sonraki istekte kullanılır
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return resp, err
+		}
+		retry.Body = body
+	}
+	retry.Header.Set("Authorization", "Bearer "+token)
+
+	resp.Body.Close()
+	return rt.next.RoundTrip(retry)
+}
+
+// reauthenticate aktif bağlantının kaynağını (.env, profil dosyası, token
+// dosyası veya kubeconfig) yeniden okuyup client'ı baştan kurar ve yeni
+// bearer token'ı döndürür
+func reauthenticate() (string, error) {
+	reauthMu.Lock()
+	defer reauthMu.Unlock()
+
+	oldToken := activeToken
+	p := activeSpec
+	namespace := activeSession.Namespace // Oturumda seçilen namespace korunur
+
+	// Kimlik bilgileri dışarıdan güncellenmiş olabilir, kaynakları yeniden oku
+	if p.Name != "" {
+		if err := profileStore.Load(); err != nil {
+			return "", err
+		}
+		if stored, exists := profileStore.Get(p.Name); exists {
+			p = stored
+		}
+	} else if p.Method == MethodServiceAccount {
+		// Hatalı satırlar atlanır; geçerli anahtarlar yine de kullanılabilir
+		if err := envManager.Load(); err != nil {
+			i18n.Fprintf(os.Stderr, "Uyarı: .env dosyası yüklenirken hata oluştu: %v\n", err)
+		}
+		p.Token = envManager.Get("K8S_TOKEN")
+		p.TokenFile = envManager.Get("K8S_TOKEN_FILE")
+	}
+
+	if err := connectProfile(p); err != nil {
+		return "", err
+	}
+	activeSession.Namespace = namespace
+
+	if activeToken != "" && activeToken == oldToken && p.TokenFile == "" && p.Method != MethodInCluster {
+		fmt.Fprintln(os.Stderr, i18n.T("Uyarı: Token değişmedi; yeni token'ı .env'e veya profile kaydedin ya da K8S_TOKEN_FILE kullanın."))
+	}
+	return activeToken, nil
+}
+
+// warnTokenExpiry bağlantı kurulurken token süresi ile ilgili uyarıyı yazdırır
+func warnTokenExpiry() {
+	if warning := TokenExpiryWarning(); warning != "" {
+		i18n.Fprintf(os.Stderr, "Uyarı: %s\n", warning)
+		return
+	}
+	if expiry, ok := tokenExpiry(activeToken); ok {
+		i18n.Fprintf(os.Stderr, "Token geçerlilik sonu: %s\n", expiry.Local().Format("2006-01-02 15:04:05"))
+	}
+}
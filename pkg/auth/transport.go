@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/client-go/rest"
+)
+
+// transportSettings .env üzerinden yönetilen ve her kimlik doğrulama
+// yöntemine aynı şekilde uygulanan bağlantı ayarları
+type transportSettings struct {
+	ProxyURL   string        // K8S_PROXY_URL: http://, https:// veya socks5://
+	ServerName string        // K8S_TLS_SERVER_NAME: sertifika doğrulamasında kullanılacak isim
+	QPS        float32       // K8S_QPS
+	Burst      int           // K8S_BURST
+	Timeout    time.Duration // K8S_TIMEOUT (örn. 30s, 2m); tanımlı değilse http zaman aşımı yoktur, istekler context ile sınırlanır
+	Insecure   bool          // K8S_INSECURE_SKIP_TLS_VERIFY
+	Pin        string        // K8S_SERVER_CERT_SHA256: sunucu sertifikasının beklenen parmak izi
+}
+
+// activeTransport aktif bağlantıya uygulanan ayarlar
+var activeTransport transportSettings
+
+// loadTransportSettings ayarları katmanlı yapılandırmadan okur ve doğrular
+func loadTransportSettings() (transportSettings, error) {
+	s := transportSettings{
+		ProxyURL:   envManager.Get("K8S_PROXY_URL"),
+		ServerName: envManager.Get("K8S_TLS_SERVER_NAME"),
+		Pin:        envManager.Get("K8S_SERVER_CERT_SHA256"),
+	}
+
+	if s.Pin != "" && !validFingerprint(s.Pin) {
+		return s, i18n.Errorf("geçersiz K8S_SERVER_CERT_SHA256 değeri: SHA-256 parmak izi (64 hex hane) olmalı")
+	}
+
+	if s.ProxyURL != "" {
+		u, err := url.Parse(s.ProxyURL)
+		if err != nil || u.Host == "" {
+			return s, i18n.Errorf("geçersiz K8S_PROXY_URL değeri: %q", s.ProxyURL)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return s, i18n.Errorf("desteklenmeyen proxy şeması: %s (http, https veya socks5 olmalı)", u.Scheme)
+		}
+	}
+
+	if value := envManager.Get("K8S_QPS"); value != "" {
+		qps, err := strconv.ParseFloat(value, 32)
+		if err != nil || qps <= 0 {
+			return s, i18n.Errorf("geçersiz K8S_QPS değeri: %q", value)
+		}
+		s.QPS = float32(qps)
+	}
+
+	if value := envManager.Get("K8S_BURST"); value != "" {
+		burst, err := strconv.Atoi(value)
+		if err != nil || burst <= 0 {
+			return s, i18n.Errorf("geçersiz K8S_BURST değeri: %q", value)
+		}
+		s.Burst = burst
+	}
+
+	if value := envManager.Get("K8S_TIMEOUT"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			return s, i18n.Errorf("geçersiz K8S_TIMEOUT değeri: %q (örn. 30s, 2m)", value)
+		}
+		s.Timeout = timeout
+	}
+
+	if value := envManager.Get("K8S_INSECURE_SKIP_TLS_VERIFY"); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return s, i18n.Errorf("geçersiz K8S_INSECURE_SKIP_TLS_VERIFY değeri: %q", value)
+		}
+		s.Insecure = insecure
+	}
+	return s, nil
+}
+
+// apply ayarları rest config'e uygular. Kubeconfig'den gelen değerler yalnızca
+// ilgili ayar tanımlıysa ezilir.
+func (s transportSettings) apply(config *rest.Config) {
+	if s.ProxyURL != "" {
+		proxyURL, _ := url.Parse(s.ProxyURL) // loadTransportSettings'te doğrulandı
+		config.Proxy = http.ProxyURL(proxyURL)
+	}
+	if s.ServerName != "" {
+		config.ServerName = s.ServerName
+	}
+	if s.QPS > 0 {
+		config.QPS = s.QPS
+	}
+	if s.Burst > 0 {
+		config.Burst = s.Burst
+	}
+	if s.Timeout > 0 {
+		// Akışlar (log takibi) bu süreden etkilenmez, bkz. session.StreamingClient
+		config.Timeout = s.Timeout
+	}
+	if s.Insecure {
+		// client-go CA ile birlikte insecure kullanılmasına izin vermez
+		config.Insecure = true
+		config.CAData = nil
+		config.CAFile = ""
+	}
+	if s.Pin != "" {
+		// Diğer sarmalayıcılardan önce eklenmeli ki temel transport'a erişebilsin
+		config.Wrap(pinnedTransport(s.Pin))
+	}
+}
+
+// InsecureWarning TLS doğrulaması kapalıysa ana menüde gösterilecek uyarıyı döndürür
+func InsecureWarning() string {
+	if activeSession == nil || !activeTransport.Insecure {
+		return ""
+	}
+	return i18n.T("TLS sertifika doğrulaması KAPALI (K8S_INSECURE_SKIP_TLS_VERIFY=true). Bağlantı ortadaki adam saldırılarına açık!")
+}
+
+// warnInsecure insecure bağlantı kurulurken dikkat çekici bir uyarı yazdırır.
+// Komut satırı çıktısını bozmamak için stderr kullanılır.
+func warnInsecure() {
+	line := strings.Repeat("!", 70)
+	fmt.Fprintln(os.Stderr, line)
+	fmt.Fprintln(os.Stderr, i18n.T("!! UYARI: TLS sertifika doğrulaması devre dışı bırakıldı!"))
+	fmt.Fprintln(os.Stderr, i18n.T("!! API sunucusunun kimliği doğrulanmıyor; token ve veriler ele geçirilebilir."))
+	fmt.Fprintln(os.Stderr, i18n.T("!! Yalnızca test ortamlarında kullanın. Kapatmak için: K8S_INSECURE_SKIP_TLS_VERIFY=false"))
+	fmt.Fprintln(os.Stderr, line)
+}
@@ -0,0 +1,448 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/info"
+	"tamerGoClient/pkg/output"
+	"tamerGoClient/pkg/resource"
+	"tamerGoClient/pkg/session"
+	"tamerGoClient/pkg/tui"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Çıkış kodları
+const (
+	ExitOK         = 0 // İşlem başarılı
+	ExitError      = 1 // API isteği veya işlem başarısız
+	ExitUsage      = 2 // Geçersiz komut veya argüman
+	ExitConnection = 3 // Cluster'a bağlanılamadı
+)
+
+// App menüsüz çalışan komut satırı arayüzüdür. Bağlantı, komut ve
+// argümanlar doğrulandıktan sonra Connect ile kurulur.
+type App struct {
+	Connect func() (*session.Session, error)
+	Stdin   io.Reader
+	Stdout  io.Writer
+	Stderr  io.Writer
+}
+
+// command bir alt komutun adı, kısa açıklaması ve çalıştırıcısı
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(a *App, args []string) int
+}
+
+var commands = []command{
+	{"get", "get <tür> [isim] [-n namespace | -A] [-l selector] [-field-selector selector] [-match regex] [-o biçim]", "Kaynakları tablo olarak listeler", (*App).runGet},
+	{"create", "create -f <dosya.yaml | ->", "YAML'daki Pod, Deployment ve Service'leri oluşturur", (*App).runCreate},
+	{"delete", "delete <pod|deployment|service> <isim> [-n namespace]", "Kaynağı siler", (*App).runDelete},
+	{"logs", "logs <pod> [-n namespace] [-c container] [-f] [-tail N]", "Pod loglarını yazdırır", (*App).runLogs},
+	{"tui", "tui [-n namespace] [-l selector] [-field-selector selector] [-match regex]", "Tam ekran, klavye ile kullanılan arayüzü açar", (*App).runTUI},
+}
+
+// New standart giriş/çıkışları kullanan bir App oluşturur
+func New(connect func() (*session.Session, error)) *App {
+	return &App{Connect: connect, Stdin: os.Stdin, Stdout: os.Stdout, Stderr: os.Stderr}
+}
+
+// Run alt komutu çalıştırır ve süreç çıkış kodunu döndürür
+func (a *App) Run(args []string) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		PrintUsage(a.Stdout)
+		return ExitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(a, args[1:])
+		}
+	}
+	i18n.Fprintf(a.Stderr, "Hata: bilinmeyen komut: %s\n\n", args[0])
+	PrintUsage(a.Stderr)
+	return ExitUsage
+}
+
+// PrintUsage alt komutların listesini yazdırır
+func PrintUsage(w io.Writer) {
+	program := filepath.Base(os.Args[0])
+	i18n.Fprintf(w, "Kullanım: %s [seçenekler] <komut> [argümanlar]\n", program)
+	i18n.Fprintf(w, "Komut verilmezse etkileşimli menü açılır.\n\nKomutlar:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-70s %s\n", i18n.T(cmd.usage), i18n.T(cmd.summary))
+	}
+	i18n.Fprintf(w, "\nDesteklenen türler: %s\n", info.KindNames())
+	i18n.Fprintf(w, "Çıktı biçimleri: %s\n", i18n.T(output.Formats))
+	i18n.Fprintf(w, "Çıkış kodları: %d başarılı, %d işlem hatası, %d kullanım hatası, %d bağlantı hatası\n",
+		ExitOK, ExitError, ExitUsage, ExitConnection)
+}
+
+// flagSet alt komut için hataları stderr'e yazan bir FlagSet oluşturur
+func (a *App) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.Stderr)
+	return fs
+}
+
+// parseArgs bayrakları konumsal argümanlarla karışık sırada kabul ederek
+// ayrıştırır (örn. "get pods -n x" ve "get -n x pods")
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// usageError kullanım hatasını yazdırır ve uygun çıkış kodunu döndürür
+func (a *App) usageError(format string, args ...interface{}) int {
+	fmt.Fprintln(a.Stderr, i18n.Sprintf("Hata: %s", i18n.Sprintf(format, args...)))
+	return ExitUsage
+}
+
+// connect bağlantıyı kurar, başarısız olursa hatayı yazdırır
+func (a *App) connect() (*session.Session, int) {
+	s, err := a.Connect()
+	if err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: Bağlantı kurulamadı: %v\n", err)
+		return nil, ExitConnection
+	}
+	return s, ExitOK
+}
+
+// targetNamespace tek bir nesne üzerinde çalışan komutlar için namespace'i belirler
+func targetNamespace(s *session.Session, namespace string) string {
+	if namespace != "" {
+		return namespace
+	}
+	if namespace = s.DefaultNamespace(); namespace != "" {
+		return namespace
+	}
+	return metav1.NamespaceDefault
+}
+
+func (a *App) runGet(args []string) int {
+	fs := a.flagSet("get")
+	namespace := fs.String("n", "", "Namespace")
+	allNamespaces := fs.Bool("A", false, i18n.T("Tüm namespace'ler"))
+	filter := filterFlags(fs)
+	outputFormat := fs.String("o", "", i18n.T("Çıktı biçimi: ")+i18n.T(output.Formats))
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	} else if err != nil {
+		return ExitUsage
+	}
+	if len(positional) < 1 || len(positional) > 2 {
+		return a.usageError("kullanım: get <tür> [isim]")
+	}
+	kind, found := info.FindKind(positional[0])
+	if !found {
+		return a.usageError("bilinmeyen kaynak türü: %s (desteklenenler: %s)", positional[0], info.KindNames())
+	}
+
+	// Komut satırında verilen selector'lar yalnızca istenen türe uygulanır
+	filter.LabelSelectorKind, filter.FieldSelectorKind = kind.Name, kind.Name
+	if err := filter.Validate(); err != nil {
+		return a.usageError("%v", err)
+	}
+
+	// Biçim verilmezse oturumun varsayılanı kullanılır; bağlanmadan önce doğrulanır
+	format := *outputFormat
+	if format != "" {
+		if _, err := output.NewPrinter(format); err != nil {
+			return a.usageError("%v", err)
+		}
+	}
+
+	s, code := a.connect()
+	if s == nil {
+		return code
+	}
+	if format == "" {
+		format = s.Settings.Output
+	}
+	printer, err := output.NewPrinter(format)
+	if err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+		return ExitUsage
+	}
+	if *allNamespaces {
+		s.Namespace = ""
+	} else if *namespace != "" {
+		s.Namespace = *namespace
+	}
+
+	s.Settings.Filter = *filter
+
+	var opts metav1.ListOptions
+	if len(positional) == 2 {
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", positional[1]).String()
+	}
+	measure := s.Measure()
+	list, err := info.Collect(s, kind.List, opts)
+	measure(kind.Name)
+	if err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %s listesi alınamadı: %v\n", kind.Name, err)
+		return ExitError
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+		return ExitError
+	}
+	if len(positional) == 2 {
+		if items = filterByName(items, positional[1]); len(items) == 0 {
+			i18n.Fprintf(a.Stderr, "Hata: %s \"%s\" bulunamadı\n", kind.Name, positional[1])
+			return ExitError
+		}
+	}
+	if len(items) == 0 && output.IsTable(format) {
+		fmt.Fprintln(a.Stderr, i18n.T("Kaynak bulunamadı."))
+		return ExitOK
+	}
+	if err := meta.SetList(list, items); err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+		return ExitError
+	}
+
+	// Tüm namespace'ler listeleniyorsa hangi namespace'e ait olduğu gösterilir
+	columns := kind.Columns
+	if kind.Namespaced && s.Namespace == "" {
+		columns = append([]output.Column{output.NamespaceColumn}, columns...)
+	}
+	if err := printer.Print(a.Stdout, list, columns); err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: Çıktı oluşturulamadı: %v\n", err)
+		return ExitError
+	}
+	return ExitOK
+}
+
+func (a *App) runCreate(args []string) int {
+	fs := a.flagSet("create")
+	file := fs.String("f", "", i18n.T("YAML dosyası (standart giriş için -)"))
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	} else if err != nil {
+		return ExitUsage
+	}
+	if *file == "" || len(positional) > 0 {
+		return a.usageError("kullanım: create -f <dosya.yaml | ->")
+	}
+
+	var data []byte
+	if *file == "-" {
+		data, err = io.ReadAll(a.Stdin)
+	} else {
+		data, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: YAML okunamadı: %v\n", err)
+		return ExitError
+	}
+
+	s, code := a.connect()
+	if s == nil {
+		return code
+	}
+
+	// Çok belgeli YAML'da hatalı belgeler atlanır, çıkış kodu hatayı bildirir
+	code = ExitOK
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			i18n.Fprintf(a.Stderr, "Hata: YAML okunamadı: %v\n", err)
+			return ExitError
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+		created, err := resource.CreateFromYAML(s, string(document))
+		if err != nil && created == "" {
+			i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+			code = ExitError
+			continue
+		} else if err != nil {
+			i18n.Fprintf(a.Stderr, "Hata: %s oluşturulamadı: %v\n", created, err)
+			code = ExitError
+			continue
+		}
+		i18n.Fprintf(a.Stdout, "%s oluşturuldu\n", created)
+	}
+	return code
+}
+
+func (a *App) runDelete(args []string) int {
+	fs := a.flagSet("delete")
+	namespace := fs.String("n", "", "Namespace")
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	} else if err != nil {
+		return ExitUsage
+	}
+	if len(positional) != 2 {
+		return a.usageError("kullanım: delete <pod|deployment|service> <isim>")
+	}
+	kind, found := info.FindKind(positional[0])
+	if !found {
+		return a.usageError("bilinmeyen kaynak türü: %s", positional[0])
+	}
+	if !resource.Supports(kind.Singular()) {
+		return a.usageError("%s silme desteklenmiyor (pod, deployment veya service)", kind.Name)
+	}
+
+	s, code := a.connect()
+	if s == nil {
+		return code
+	}
+	ns := targetNamespace(s, *namespace)
+	if err := resource.Delete(s, kind.Singular(), ns, positional[1]); err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %s/%s silinemedi: %v\n", kind.Singular(), positional[1], err)
+		return ExitError
+	}
+	i18n.Fprintf(a.Stdout, "%s/%s silindi\n", kind.Singular(), positional[1])
+	return ExitOK
+}
+
+func (a *App) runLogs(args []string) int {
+	fs := a.flagSet("logs")
+	namespace := fs.String("n", "", "Namespace")
+	container := fs.String("c", "", i18n.T("Container adı"))
+	follow := fs.Bool("f", false, i18n.T("Logları canlı takip et"))
+	tail := fs.Int64("tail", -1, i18n.T("Yalnızca son N satır (-1: tümü)"))
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	} else if err != nil {
+		return ExitUsage
+	}
+	if len(positional) != 1 {
+		return a.usageError("kullanım: logs <pod>")
+	}
+
+	s, code := a.connect()
+	if s == nil {
+		return code
+	}
+	ns := targetNamespace(s, *namespace)
+
+	opts := &corev1.PodLogOptions{Container: *container, Follow: *follow}
+	if *tail >= 0 {
+		opts.TailLines = tail
+	}
+	if opts.Container == "" {
+		if opts.Container, err = defaultContainer(s, ns, positional[0]); err != nil {
+			i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+			return ExitError
+		}
+	}
+
+	// Ctrl+C takibi hata olmadan sonlandırır
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := info.StreamPodLogs(ctx, s, ns, positional[0], opts, a.Stdout); err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: Loglar alınamadı: %v\n", err)
+		return ExitError
+	}
+	return ExitOK
+}
+
+// filterByName alan seçicisini desteklemeyen sunuculara karşı isim
+// eşleşmesini istemci tarafında da uygular
+func filterByName(items []runtime.Object, name string) []runtime.Object {
+	var matched []runtime.Object
+	for _, item := range items {
+		if accessor, err := meta.Accessor(item); err == nil && accessor.GetName() == name {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// defaultContainer -c verilmediğinde logları okunacak container'ı belirler
+func defaultContainer(s *session.Session, namespace, podName string) (string, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+
+	pod, err := s.Client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return info.DefaultContainer(pod)
+}
+
+// filterFlags listeleme yapan komutların ortak filtre bayraklarını tanımlar
+func filterFlags(fs *flag.FlagSet) *session.Filter {
+	filter := &session.Filter{}
+	fs.StringVar(&filter.LabelSelector, "l", "", i18n.T("Label selector (örn. app=web)"))
+	fs.StringVar(&filter.FieldSelector, "field-selector", "", i18n.T("Field selector (örn. status.phase=Running)"))
+	fs.StringVar(&filter.Name, "match", "", i18n.T("İsim filtresi: büyük/küçük harf duyarsız metin veya /regex/"))
+	return filter
+}
+
+func (a *App) runTUI(args []string) int {
+	fs := a.flagSet("tui")
+	namespace := fs.String("n", "", "Namespace")
+	filter := filterFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	} else if err != nil {
+		return ExitUsage
+	}
+	if len(positional) > 0 {
+		return a.usageError("kullanım: tui [-n namespace]")
+	}
+	// Label selector namespace'li tüm türlere, field selector yalnızca podlara uygulanır
+	if filter.FieldSelector != "" {
+		filter.FieldSelectorKind = "pods"
+	}
+	if err := filter.Validate(); err != nil {
+		return a.usageError("%v", err)
+	}
+
+	s, code := a.connect()
+	if s == nil {
+		return code
+	}
+	if *namespace != "" {
+		s.Namespace = *namespace
+	}
+	s.Settings.Filter = *filter
+	if err := tui.Run(s); err != nil {
+		i18n.Fprintf(a.Stderr, "Hata: %v\n", err)
+		return ExitError
+	}
+	return ExitOK
+}
@@ -0,0 +1,323 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"tamerGoClient/internal/testutil"
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+// newTestApp fake client kullanan bir oturuma bağlanan App ile çıktı
+// tamponlarını döndürür
+func newTestApp(objects ...runtime.Object) (*App, *session.Session, *bytes.Buffer, *bytes.Buffer) {
+	s := &session.Session{Name: "test", Client: fake.NewClientset(objects...)}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	app := &App{
+		Connect: func() (*session.Session, error) { return s, nil },
+		Stdin:   strings.NewReader(""),
+		Stdout:  stdout,
+		Stderr:  stderr,
+	}
+	return app, s, stdout, stderr
+}
+
+func pod(namespace, name string, containers ...string) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, container := range containers {
+		p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: container})
+	}
+	return p
+}
+
+func TestGetPods(t *testing.T) {
+	app, _, stdout, _ := newTestApp(pod("default", "web", "app"), pod("client-access", "worker", "app"))
+
+	if code := app.Run([]string{"get", "pods"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d, %d beklendi", code, ExitOK)
+	}
+	out := stdout.String()
+	for _, want := range []string{"NAMESPACE", "client-access", "worker", "web", "Running"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("çıktıda %q bulunamadı:\n%s", want, out)
+		}
+	}
+}
+
+func TestGetPodsInNamespace(t *testing.T) {
+	app, _, stdout, _ := newTestApp(pod("default", "web", "app"), pod("client-access", "worker", "app"))
+
+	if code := app.Run([]string{"get", "po", "-n", "client-access"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	out := stdout.String()
+	if !strings.Contains(out, "worker") || strings.Contains(out, "web") {
+		t.Errorf("yalnızca client-access podları listelenmeli:\n%s", out)
+	}
+	if strings.Contains(out, "NAMESPACE") {
+		t.Errorf("tek namespace listelenirken NAMESPACE sütunu olmamalı:\n%s", out)
+	}
+}
+
+func TestGetByName(t *testing.T) {
+	app, _, stdout, stderr := newTestApp(pod("default", "web", "app"), pod("default", "db", "app"))
+
+	if code := app.Run([]string{"get", "pod", "db", "-n", "default"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if !strings.Contains(stdout.String(), "db") || strings.Contains(stdout.String(), "web") {
+		t.Errorf("yalnızca istenen pod listelenmeli:\n%s", stdout)
+	}
+
+	if code := app.Run([]string{"get", "pod", "yok", "-n", "default"}); code != ExitError {
+		t.Errorf("bulunamayan kaynak için çıkış kodu = %d, %d beklendi", code, ExitError)
+	}
+	if !strings.Contains(stderr.String(), "bulunamadı") {
+		t.Errorf("bulunamadı hatası bekleniyordu: %s", stderr)
+	}
+}
+
+func TestGetWithFilters(t *testing.T) {
+	web := pod("default", "web-1", "app")
+	web.Labels = map[string]string{"app": "web"}
+	canary := pod("default", "web-canary", "app")
+	canary.Labels = map[string]string{"app": "web"}
+	api := pod("default", "api-1", "app")
+	api.Labels = map[string]string{"app": "api"}
+	app, s, stdout, _ := newTestApp(web, canary, api)
+
+	if code := app.Run([]string{"get", "pods", "-l", "app=web", "-match", "/[0-9]$/"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	out := stdout.String()
+	if !strings.Contains(out, "web-1") || strings.Contains(out, "web-canary") || strings.Contains(out, "api-1") {
+		t.Errorf("yalnızca web-1 listelenmeli:\n%s", out)
+	}
+	if s.Settings.Filter.LabelSelector != "app=web" {
+		t.Errorf("filtre oturuma kaydedilmeliydi: %+v", s.Settings.Filter)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"bilinmeyen"},
+		{"get"},
+		{"get", "widgets"},
+		{"get", "pods", "-x"},
+		{"get", "pods", "-l", "app=("},
+		{"get", "pods", "-match", "/web(/"},
+		{"tui", "-field-selector", "status.phase"},
+		{"delete", "configmap", "x"},
+		{"delete", "pod"},
+		{"create"},
+		{"logs"},
+	} {
+		app, _, _, _ := newTestApp()
+		app.Connect = func() (*session.Session, error) {
+			t.Errorf("%v için bağlantı kurulmamalıydı", args)
+			return nil, errors.New("beklenmeyen bağlantı")
+		}
+		if code := app.Run(args); code != ExitUsage {
+			t.Errorf("%v: çıkış kodu = %d, %d beklendi", args, code, ExitUsage)
+		}
+	}
+}
+
+func TestEnglishMessages(t *testing.T) {
+	i18n.SetLanguage(i18n.English)
+	defer i18n.SetLanguage(i18n.Turkish)
+
+	app, _, stdout, stderr := newTestApp(pod("default", "web", "app"))
+	if code := app.Run([]string{"get", "widgets"}); code != ExitUsage {
+		t.Fatalf("çıkış kodu = %d, %d beklendi", code, ExitUsage)
+	}
+	if !strings.Contains(stderr.String(), "Error: unknown resource kind: widgets") {
+		t.Errorf("İngilizce hata mesajı bekleniyordu: %s", stderr)
+	}
+
+	if code := app.Run([]string{"get", "pods", "-A"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d, %d beklendi", code, ExitOK)
+	}
+	header := strings.Fields(strings.SplitN(stdout.String(), "\n", 2)[0])
+	if len(header) < 2 || header[0] != "NAMESPACE" || header[1] != "NAME" {
+		t.Errorf("İngilizce tablo başlıkları bekleniyordu: %v", header)
+	}
+}
+
+func TestConnectionFailure(t *testing.T) {
+	app, _, _, stderr := newTestApp()
+	app.Connect = func() (*session.Session, error) { return nil, errors.New("sunucuya ulaşılamadı") }
+
+	if code := app.Run([]string{"get", "pods"}); code != ExitConnection {
+		t.Errorf("çıkış kodu = %d, %d beklendi", code, ExitConnection)
+	}
+	if !strings.Contains(stderr.String(), "sunucuya ulaşılamadı") {
+		t.Errorf("bağlantı hatası yazdırılmalı: %s", stderr)
+	}
+}
+
+func TestCreateFromFile(t *testing.T) {
+	app, s, stdout, stderr := newTestApp()
+	s.Namespace = "team-a"
+
+	path := filepath.Join(t.TempDir(), "app.yaml")
+	content := `apiVersion: v1
+kind: Service
+metadata:
+  name: api
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: api-0
+spec:
+  containers:
+  - name: app
+    image: nginx
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Desteklenmeyen ConfigMap diğerlerinin oluşturulmasını engellemez
+	if code := app.Run([]string{"create", "-f", path}); code != ExitError {
+		t.Errorf("çıkış kodu = %d, %d beklendi", code, ExitError)
+	}
+	for _, want := range []string{"service/api oluşturuldu", "pod/api-0 oluşturuldu"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("çıktıda %q bulunamadı:\n%s", want, stdout)
+		}
+	}
+	if !strings.Contains(stderr.String(), "desteklenmeyen") {
+		t.Errorf("ConfigMap için hata bekleniyordu: %s", stderr)
+	}
+	if _, err := s.Client.CoreV1().Pods("team-a").Get(context.Background(), "api-0", metav1.GetOptions{}); err != nil {
+		t.Errorf("pod oturum namespace'inde oluşturulmalıydı: %v", err)
+	}
+}
+
+func TestCreateFromStdin(t *testing.T) {
+	app, s, _, _ := newTestApp()
+	app.Stdin = strings.NewReader("apiVersion: v1\nkind: Pod\nmetadata:\n  name: p\n  namespace: default\n")
+
+	if code := app.Run([]string{"create", "-f", "-"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if _, err := s.Client.CoreV1().Pods("default").Get(context.Background(), "p", metav1.GetOptions{}); err != nil {
+		t.Errorf("pod oluşturulmalıydı: %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	app, s, stdout, _ := newTestApp(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}})
+
+	if code := app.Run([]string{"delete", "deploy", "api"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if !strings.Contains(stdout.String(), "deployment/api silindi") {
+		t.Errorf("silme mesajı bekleniyordu: %s", stdout)
+	}
+	_, err := s.Client.AppsV1().Deployments("default").Get(context.Background(), "api", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("deployment silinmeliydi, alınan: %v", err)
+	}
+
+	if code := app.Run([]string{"delete", "deployment", "api"}); code != ExitError {
+		t.Errorf("olmayan kaynak için çıkış kodu = %d, %d beklendi", code, ExitError)
+	}
+}
+
+func TestLogs(t *testing.T) {
+	app, _, stdout, _ := newTestApp(pod("default", "web", "app"))
+
+	if code := app.Run([]string{"logs", "web"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if !strings.Contains(stdout.String(), "fake logs") {
+		t.Errorf("pod logları yazdırılmalı: %s", stdout)
+	}
+}
+
+func TestLogsRequiresContainer(t *testing.T) {
+	app, _, _, stderr := newTestApp(pod("default", "web", "app", "sidecar"))
+
+	if code := app.Run([]string{"logs", "web"}); code != ExitError {
+		t.Errorf("çıkış kodu = %d, %d beklendi", code, ExitError)
+	}
+	if !strings.Contains(stderr.String(), "app, sidecar") {
+		t.Errorf("container listesi gösterilmeli: %s", stderr)
+	}
+
+	app, _, _, _ = newTestApp(pod("default", "web", "app", "sidecar"))
+	if code := app.Run([]string{"logs", "web", "-c", "sidecar"}); code != ExitOK {
+		t.Errorf("-c ile çıkış kodu = %d", code)
+	}
+}
+
+func TestGetOutputFormats(t *testing.T) {
+	app, s, stdout, _ := newTestApp(pod("default", "web", "app"), pod("client-access", "worker", "app"))
+
+	if code := app.Run([]string{"get", "pods", "-o", "jsonpath={.items[*].metadata.name}"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if got := stdout.String(); got != "worker web\n" {
+		t.Errorf("jsonpath çıktısı: %q", got)
+	}
+
+	// Biçim verilmezse oturumun varsayılanı kullanılır
+	stdout.Reset()
+	s.Settings.Output = "csv"
+	if code := app.Run([]string{"get", "pods", "-n", "default"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if !strings.HasPrefix(stdout.String(), "İSİM,HAZIR,DURUM") || !strings.Contains(stdout.String(), "web,0/1,Running") {
+		t.Errorf("csv çıktısı bekleniyordu:\n%s", stdout)
+	}
+
+	// İsim filtresi makine okunur çıktıya da uygulanır
+	stdout.Reset()
+	if code := app.Run([]string{"get", "pod", "web", "-n", "default", "-o", "json"}); code != ExitOK {
+		t.Fatalf("çıkış kodu = %d", code)
+	}
+	if strings.Contains(stdout.String(), "worker") || !strings.Contains(stdout.String(), `"name": "web"`) {
+		t.Errorf("yalnızca web podu beklendi:\n%s", stdout)
+	}
+}
+
+func TestGetInvalidOutputFormat(t *testing.T) {
+	app, _, _, stderr := newTestApp()
+	if code := app.Run([]string{"get", "pods", "-o", "xml"}); code != ExitUsage {
+		t.Errorf("çıkış kodu = %d, %d beklendi", code, ExitUsage)
+	}
+	if !strings.Contains(stderr.String(), "desteklenmeyen çıktı biçimi") {
+		t.Errorf("biçim hatası yazdırılmalı: %s", stderr)
+	}
+}
@@ -0,0 +1,305 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"tamerGoClient/pkg/i18n"
+)
+
+// envLine .env dosyasındaki tek bir satırı temsil eder. Değiştirilmemiş
+// satırlar (yorumlar, boş satırlar, dokunulmamış anahtarlar) dosyaya
+// okunduğu haliyle geri yazılır.
+type envLine struct {
+	raw     string // Satırın dosyadaki orijinal hali
+	key     string // Yorum/boş satırlarda boş
+	value   string // Tırnakları ve kaçışları çözülmüş, genişletilmiş değer
+	export  bool   // "export KEY=..." biçiminde mi
+	comment string // Satır sonu yorumu ("# ..." dahil)
+	dirty   bool   // Set ile değiştirildiyse yeniden üretilir
+}
+
+// parseEnvLine tek bir satırı ayrıştırır. lookup ${VAR} genişletmesinde
+// kullanılır.
+func parseEnvLine(raw string, lookup func(string) (string, bool)) (envLine, error) {
+	line := envLine{raw: raw}
+
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return line, nil
+	}
+
+	if rest, ok := strings.CutPrefix(trimmed, "export "); ok {
+		line.export = true
+		trimmed = strings.TrimSpace(rest)
+	}
+
+	key, rest, found := strings.Cut(trimmed, "=")
+	if !found {
+		return line, i18n.Errorf("'=' bulunamadı: %q", raw)
+	}
+	line.key = strings.TrimSpace(key)
+	if line.key == "" || strings.ContainsAny(line.key, " \t") {
+		return line, i18n.Errorf("geçersiz anahtar: %q", key)
+	}
+
+	rest = strings.TrimLeft(rest, " \t")
+	switch {
+	case strings.HasPrefix(rest, `"`):
+		value, tail, err := parseDoubleQuoted(rest[1:])
+		if err != nil {
+			return line, fmt.Errorf("%s: %v", line.key, err)
+		}
+		line.value = expandVars(value, lookup)
+		line.comment = trailingComment(tail)
+	case strings.HasPrefix(rest, "'"):
+		end := strings.Index(rest[1:], "'")
+		if end < 0 {
+			return line, i18n.Errorf("%s: kapanmayan tek tırnak", line.key)
+		}
+		line.value = rest[1 : end+1] // Tek tırnak içinde kaçış ve genişletme yok
+		line.comment = trailingComment(rest[end+2:])
+	default:
+		// Tırnaksız değerlerde yorum ancak öncesinde boşluk varsa başlar
+		value := rest
+		if i := strings.Index(rest, " #"); i >= 0 {
+			value, line.comment = rest[:i], strings.TrimSpace(rest[i:])
+		} else if i := strings.Index(rest, "\t#"); i >= 0 {
+			value, line.comment = rest[:i], strings.TrimSpace(rest[i:])
+		}
+		line.value = expandVars(strings.TrimSpace(value), lookup)
+	}
+	return line, nil
+}
+
+// parseDoubleQuoted açılış tırnağından sonraki metni kapanış tırnağına kadar
+// okur ve kaçış dizilerini çözer. Kalan metni de döndürür.
+func parseDoubleQuoted(s string) (string, string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), s[i+1:], nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", "", i18n.Errorf("tamamlanmamış kaçış dizisi")
+			}
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '$':
+				// Genişletmeden korunması için işaretle, expandVars literal "$" yazar
+				b.WriteByte(escapedDollar)
+			default:
+				b.WriteByte(s[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", i18n.Errorf("kapanmayan çift tırnak")
+}
+
+func trailingComment(tail string) string {
+	tail = strings.TrimSpace(tail)
+	if strings.HasPrefix(tail, "#") {
+		return tail
+	}
+	return ""
+}
+
+// escapedDollar çift tırnak içindeki "\$" kaçışının genişletmeye kadar
+// taşındığı işaret baytı
+const escapedDollar = 0
+
+// expandVars ${VAR} ve $VAR referanslarını lookup ile genişletir
+func expandVars(value string, lookup func(string) (string, bool)) string {
+	if !strings.ContainsAny(value, "$\x00") {
+		return value
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c == escapedDollar {
+			b.WriteByte('$')
+			continue
+		}
+		if c != '$' || i+1 >= len(value) {
+			b.WriteByte(c)
+			continue
+		}
+
+		var name string
+		if value[i+1] == '{' {
+			end := strings.IndexByte(value[i+2:], '}')
+			if end < 0 {
+				b.WriteByte(c)
+				continue
+			}
+			name = value[i+2 : i+2+end]
+			i += end + 2
+		} else {
+			j := i + 1
+			for j < len(value) && isVarChar(value[j]) {
+				j++
+			}
+			if j == i+1 {
+				b.WriteByte(c)
+				continue
+			}
+			name = value[i+1 : j]
+			i = j - 1
+		}
+
+		if v, ok := lookup(name); ok {
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+func isVarChar(c byte) bool {
+	return c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+// render değiştirilmiş bir satırı dosya formatına çevirir
+func (l envLine) render() string {
+	if !l.dirty {
+		return l.raw
+	}
+
+	var b strings.Builder
+	if l.export {
+		b.WriteString("export ")
+	}
+	b.WriteString(l.key)
+	b.WriteByte('=')
+	b.WriteString(quoteEnvValue(l.value))
+	if l.comment != "" {
+		b.WriteByte(' ')
+		b.WriteString(l.comment)
+	}
+	return b.String()
+}
+
+// quoteEnvValue gerekiyorsa değeri çift tırnak içine alıp kaçışlar
+func quoteEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t\n\r\"'#$\\") {
+		return value
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"', '\\', '$':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// SplitArgs EXEC_ARGS gibi komut argümanları içeren bir değeri böler. Değer
+// JSON dizisi (örn. ["token", "--cluster", "prod eu"]) olabilir; değilse
+// boşluklardan bölünür. Tek veya çift tırnak içindeki boşluklar argümanı
+// bölmez, tek tırnak dışında ters eğik çizgi sonraki karakteri kaçışlar.
+func SplitArgs(value string) ([]string, error) {
+	if trimmed := strings.TrimSpace(value); strings.HasPrefix(trimmed, "[") {
+		var args []string
+		if err := json.Unmarshal([]byte(trimmed), &args); err != nil {
+			return nil, i18n.Errorf("geçersiz JSON argüman dizisi: %v", err)
+		}
+		return args, nil
+	}
+
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range value {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if escaped {
+		return nil, i18n.Errorf("tamamlanmamış kaçış dizisi")
+	}
+	if quote != 0 {
+		return nil, i18n.Errorf("kapanmayan tırnak")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// writeFileAtomic dosyayı önce aynı dizindeki geçici dosyaya yazıp ardından
+// yerine taşır, böylece yazma sırasında çökme mevcut dosyayı bozmaz
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // Rename başarılıysa dosya zaten yok
+
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -2,15 +2,22 @@ package config
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+
+	"tamerGoClient/pkg/i18n"
 )
 
 type EnvManager struct {
 	filePath       string
-	envMap         map[string]string
+	lines          []envLine         // Dosyanın satır satır orijinal düzeni
+	envMap         map[string]string // Anahtar -> çözülmüş değer
 	predefinedKeys []string
+	vault          *Vault            // Açıksa gizli anahtarlar .env yerine burada tutulur
+	overrides      map[string]string // Komut satırı katmanı
+	userConfig     *EnvManager       // XDG kullanıcı config katmanı
 }
 
 func NewEnvManager(filePath string) *EnvManager {
@@ -20,69 +27,191 @@ func NewEnvManager(filePath string) *EnvManager {
 		predefinedKeys: []string{
 			"API_SERVER",
 			"K8S_TOKEN",
+			"K8S_TOKEN_FILE",
 			"CA_CERT_PATH",
 			"KUBECONFIG_PATH",
+			"CA_CERT_DATA",
+			"CLIENT_CERT_PATH",
+			"CLIENT_CERT_DATA",
+			"CLIENT_KEY_PATH",
+			"CLIENT_KEY_DATA",
+			"EXEC_COMMAND",
+			"EXEC_ARGS",
+			"EXEC_ENV",
+			"EXEC_API_VERSION",
+			"OIDC_ISSUER_URL",
+			"OIDC_CLIENT_ID",
+			"OIDC_CLIENT_SECRET",
+			"OIDC_REFRESH_TOKEN",
+			"K8S_PROXY_URL",
+			"K8S_TLS_SERVER_NAME",
+			"K8S_QPS",
+			"K8S_BURST",
+			"K8S_TIMEOUT",
+			"K8S_INSECURE_SKIP_TLS_VERIFY",
+			"K8S_SERVER_CERT_SHA256",
+			"K8S_NAMESPACE",
+			"OUTPUT_FORMAT",
+			"UI_LANGUAGE",
+			"K8S_DEBUG",
 		},
 	}
 }
 
 func (em *EnvManager) Load() error {
-	file, err := os.Open(em.filePath)
+	em.lines = nil
+	em.envMap = make(map[string]string)
+
+	data, err := os.ReadFile(em.filePath)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil // Dosya yoksa boş map ile devam et
 		}
 		return err
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
+	// ${VAR} önce dosyada daha önce tanımlanan anahtarlara, sonra ortam değişkenlerine bakar
+	lookup := func(name string) (string, bool) {
+		if value, exists := em.envMap[name]; exists {
+			return value, true
+		}
+		return os.LookupEnv(name)
+	}
+
+	// Ayrıştırılamayan satırlar atlanır ama dosyaya olduğu gibi geri yazılır;
+	// böylece tek bir hatalı satır yüzünden Save diğer değerleri silmez
+	var problems []error
+	content := strings.TrimSuffix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i, raw := range strings.Split(content, "\n") {
+		line, err := parseEnvLine(raw, lookup)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("%s:%d: %v", em.filePath, i+1, err))
+			line = envLine{raw: raw}
 		}
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			em.envMap[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		em.lines = append(em.lines, line)
+		if line.key != "" {
+			em.envMap[line.key] = line.value
 		}
 	}
-	return scanner.Err()
+	return errors.Join(problems...)
 }
 
+// Save dosyayı orijinal düzenini (yorumlar, boş satırlar, sıra) koruyarak
+// atomik olarak yazar
 func (em *EnvManager) Save() error {
-	file, err := os.Create(em.filePath)
-	if err != nil {
+	var b strings.Builder
+	for _, line := range em.lines {
+		b.WriteString(line.render())
+		b.WriteByte('\n')
+	}
+
+	// Token içerebileceği için dosya sadece sahibi tarafından okunabilir
+	if err := writeFileAtomic(em.filePath, []byte(b.String()), 0600); err != nil {
 		return err
 	}
-	defer file.Close()
 
-	for key, value := range em.envMap {
-		_, err := fmt.Fprintf(file, "%s=%s\n", key, value)
-		if err != nil {
-			return err
-		}
+	if em.vaultActive() {
+		return em.vault.Save()
 	}
 	return nil
 }
 
-func (em *EnvManager) Set(key, value string) {
+// Set anahtarı yazılabilir katmana kaydeder; gizli anahtarlar kasa açıksa
+// kasaya yazılır. Kasa dosyası olup da kilitliyse (parola hatalı veya
+// girilmemiş) gizli değer düz metin olarak .env'e yazılmaz, ErrVaultLocked
+// döner. Gizli olmayan anahtarlar için hata dönmez.
+func (em *EnvManager) Set(key, value string) error {
+	if IsSecretKey(key) {
+		if em.vaultActive() {
+			em.vault.Set(key, value)
+			em.delete(key)
+			return nil
+		}
+		if em.vault != nil && em.vault.Exists() {
+			return ErrVaultLocked
+		}
+	}
+
 	em.envMap[key] = value
+	// Aynı anahtar birden fazla kez tanımlıysa geçerli olan son tanım güncellenir
+	for i := len(em.lines) - 1; i >= 0; i-- {
+		if em.lines[i].key == key {
+			if em.lines[i].value != value {
+				em.lines[i].value = value
+				em.lines[i].dirty = true
+			}
+			return nil
+		}
+	}
+	em.lines = append(em.lines, envLine{key: key, value: value, dirty: true})
+	return nil
+}
+
+// FilePath yazılabilir .env katmanının dosya yolunu döndürür
+func (em *EnvManager) FilePath() string {
+	return em.filePath
 }
 
+// Get anahtarın tüm katmanlar değerlendirildikten sonraki etkin değerini döndürür
 func (em *EnvManager) Get(key string) string {
-	return em.envMap[key]
+	value, _ := em.Lookup(key)
+	return value
+}
+
+// Unset anahtarı .env'den ve açıksa kasadan kaldırır
+func (em *EnvManager) Unset(key string) {
+	if em.vaultActive() {
+		em.vault.Delete(key)
+	}
+	em.delete(key)
+}
+
+// delete anahtarın tüm tanımlarını dosyadan kaldırır
+func (em *EnvManager) delete(key string) {
+	delete(em.envMap, key)
+	lines := em.lines[:0]
+	for _, line := range em.lines {
+		if line.key != key {
+			lines = append(lines, line)
+		}
+	}
+	em.lines = lines
+}
+
+// AttachVault gizli anahtarlar için şifreli kasayı kullanmaya başlar.
+// Kasa dosyası varsa parola sorulur (veya GOCLIENT_VAULT_PASSPHRASE'den okunur).
+func (em *EnvManager) AttachVault(vault *Vault) error {
+	em.vault = vault
+	if !vault.Exists() {
+		return nil
+	}
+
+	passphrase := os.Getenv(VaultPassphraseEnv)
+	if passphrase == "" {
+		var err error
+		passphrase, err = ReadPassphrase(i18n.T("Şifreli kasa parolası: "))
+		if err != nil {
+			return err
+		}
+	}
+	return vault.Unlock(passphrase)
+}
+
+func (em *EnvManager) vaultActive() bool {
+	return em.vault != nil && em.vault.Unlocked()
 }
 
 func (em *EnvManager) ShowEnvMenu() {
 	for {
-		fmt.Println("\n=== .env Dosyası Yönetimi ===")
-		fmt.Println("1. Mevcut Değerleri Göster")
-		fmt.Println("2. Değer Ekle/Güncelle")
-		fmt.Println("3. Önceki Menüye Dön")
+		fmt.Println(i18n.T("\n=== .env Dosyası Yönetimi ==="))
+		fmt.Println(i18n.T("1. Mevcut Değerleri Göster"))
+		fmt.Println(i18n.T("2. Değer Ekle/Güncelle"))
+		fmt.Println(i18n.T("3. Gizli Değerleri Şifreli Kasaya Taşı"))
+		fmt.Println(i18n.T("4. Etkin Yapılandırmayı ve Kaynaklarını Göster"))
+		fmt.Println(i18n.T("5. Önceki Menüye Dön"))
 
 		var choice int
-		fmt.Print("Seçiminiz (1-3): ")
+		fmt.Print(i18n.T("Seçiminiz (1-5): "))
 		fmt.Scanf("%d", &choice)
 
 		switch choice {
@@ -91,53 +220,134 @@ func (em *EnvManager) ShowEnvMenu() {
 		case 2:
 			em.updateValue()
 		case 3:
+			em.migrateToVault()
+		case 4:
+			em.ShowEffectiveConfig()
+		case 5:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
 func (em *EnvManager) displayCurrentValues() {
-	fmt.Println("\nMevcut .env Değerleri:")
-	if len(em.envMap) == 0 {
-		fmt.Println("Henüz hiç değer yok.")
+	fmt.Println(i18n.T("\nMevcut .env Değerleri:"))
+	if len(em.envMap) == 0 && !em.vaultActive() {
+		fmt.Println(i18n.T("Henüz hiç değer yok."))
 		return
 	}
+	for _, line := range em.lines {
+		if line.key != "" {
+			fmt.Printf("%s=%s\n", line.key, displayValue(line.key, em.envMap[line.key]))
+		}
+	}
+
+	if em.vaultActive() {
+		fmt.Println(i18n.T("\nŞifreli Kasadaki Değerler:"))
+		for _, key := range em.vault.Keys() {
+			value, _ := em.vault.Get(key)
+			fmt.Printf("%s=%s\n", key, displayValue(key, value))
+		}
+	}
+}
+
+// displayValue gizli anahtarların değerini ekranda maskeler
+func displayValue(key, value string) string {
+	if value == "" || !IsSecretKey(key) {
+		return value
+	}
+	if len(value) <= 8 {
+		return "********"
+	}
+	return "********" + value[len(value)-4:]
+}
+
+// migrateToVault düz .env'deki gizli değerleri parola korumalı kasaya taşır
+func (em *EnvManager) migrateToVault() {
+	if em.vault == nil {
+		fmt.Println(i18n.T("Hata: Kasa yapılandırılmamış."))
+		return
+	}
+
+	if !em.vault.Unlocked() {
+		if em.vault.Exists() {
+			fmt.Println(i18n.T("Hata: Kasa dosyası mevcut ama kilitli. Programı yeniden başlatıp parolayı girin."))
+			return
+		}
+
+		passphrase, err := ReadPassphrase(i18n.T("Yeni kasa parolası: "))
+		if err != nil {
+			i18n.Printf("Hata: Parola okunamadı: %v\n", err)
+			return
+		}
+		confirm, err := ReadPassphrase(i18n.T("Parolayı tekrar girin: "))
+		if err != nil {
+			i18n.Printf("Hata: Parola okunamadı: %v\n", err)
+			return
+		}
+		if passphrase != confirm {
+			fmt.Println(i18n.T("Hata: Parolalar eşleşmiyor!"))
+			return
+		}
+		if err := em.vault.Unlock(passphrase); err != nil {
+			i18n.Printf("Hata: Kasa oluşturulamadı: %v\n", err)
+			return
+		}
+	}
+
+	// Profillerin gizli değerleri de (PROFILE_*) taşınır
+	moved := 0
 	for key, value := range em.envMap {
-		fmt.Printf("%s=%s\n", key, value)
+		if IsSecretKey(key) {
+			em.vault.Set(key, value)
+			em.delete(key)
+			moved++
+		}
 	}
+
+	if err := em.Save(); err != nil {
+		i18n.Printf("Hata: Değerler kaydedilemedi: %v\n", err)
+		return
+	}
+	i18n.Printf("%d gizli değer şifreli kasaya taşındı.\n", moved)
 }
 
 func (em *EnvManager) updateValue() {
-	fmt.Println("\nMevcut anahtarlar:")
+	fmt.Println(i18n.T("\nMevcut anahtarlar:"))
 	for i, key := range em.predefinedKeys {
-		currentValue := em.Get(key)
+		currentValue := displayValue(key, em.Get(key))
 		if currentValue == "" {
-			currentValue = "<boş>"
+			currentValue = i18n.T("<boş>")
 		}
 		fmt.Printf("%d. %s = %s\n", i+1, key, currentValue)
 	}
 
 	var choice int
-	fmt.Print("\nGüncellenecek değerin numarası (1-4): ")
+	i18n.Printf("\nGüncellenecek değerin numarası (1-%d): ", len(em.predefinedKeys))
 	fmt.Scanf("%d", &choice)
 
 	if choice < 1 || choice > len(em.predefinedKeys) {
-		fmt.Println("Geçersiz seçim!")
+		fmt.Println(i18n.T("Geçersiz seçim!"))
 		return
 	}
 
 	key := em.predefinedKeys[choice-1]
-	fmt.Printf("Yeni değer (%s): ", key)
+	i18n.Printf("Yeni değer (%s): ", key)
 	scanner := bufio.NewScanner(os.Stdin)
 	if scanner.Scan() {
 		value := strings.TrimSpace(scanner.Text())
-		em.Set(key, value)
+		if err := em.Set(key, value); err != nil {
+			i18n.Printf("Hata: %s kaydedilemedi: %v\n", key, err)
+			return
+		}
 		if err := em.Save(); err != nil {
-			fmt.Printf("Hata: Değerler kaydedilemedi: %v\n", err)
+			i18n.Printf("Hata: Değerler kaydedilemedi: %v\n", err)
 			return
 		}
-		fmt.Println("Değer başarıyla kaydedildi!")
+		fmt.Println(i18n.T("Değer başarıyla kaydedildi!"))
+		if _, source := em.Lookup(key); source > SourceVault {
+			i18n.Printf("Uyarı: %s değeri %s tarafından ezildiği için bu değer kullanılmayacak.\n", key, source)
+		}
 	}
 }
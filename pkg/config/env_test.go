@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLoadKeepsMalformedLinesOnSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	content := "API_SERVER=https://k8s.example:6443\nbu satırda eşittir yok\nK8S_TOKEN=abc\nCA_CERT_PATH=/etc/ca.crt\n"
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	em := NewEnvManager(path)
+	err := em.Load()
+	if err == nil || !strings.Contains(err.Error(), ":2:") {
+		t.Errorf("hatalı satır satır numarasıyla bildirilmeli: %v", err)
+	}
+	if em.Get("K8S_TOKEN") != "abc" || em.Get("CA_CERT_PATH") != "/etc/ca.crt" {
+		t.Errorf("hatalı satırdan sonraki anahtarlar yüklenmeli: %q %q", em.Get("K8S_TOKEN"), em.Get("CA_CERT_PATH"))
+	}
+
+	em.Set("K8S_NAMESPACE", "client-access")
+	if err := em.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := content + "K8S_NAMESPACE=client-access\n"; string(data) != want {
+		t.Errorf("dosya korunmalıydı:\n%s\nbeklenen:\n%s", data, want)
+	}
+}
+
+func TestSetRefusesSecretsWhenVaultLocked(t *testing.T) {
+	dir := t.TempDir()
+	envPath, vaultPath := filepath.Join(dir, ".env"), filepath.Join(dir, ".env.vault")
+
+	vault := NewVault(vaultPath)
+	if err := vault.Unlock("doğru-parola"); err != nil {
+		t.Fatal(err)
+	}
+	vault.Set("K8S_TOKEN", "eski")
+	if err := vault.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(VaultPassphraseEnv, "yanlış-parola")
+	em := NewEnvManager(envPath)
+	if err := em.AttachVault(NewVault(vaultPath)); !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("yanlış parola ErrWrongPassphrase döndürmeli: %v", err)
+	}
+
+	if err := em.Set("K8S_TOKEN", "gizli-token"); !errors.Is(err, ErrVaultLocked) {
+		t.Errorf("kilitli kasada gizli anahtar reddedilmeli: %v", err)
+	}
+	if err := em.Set("API_SERVER", "https://k8s.example:6443"); err != nil {
+		t.Errorf("gizli olmayan anahtar kaydedilmeli: %v", err)
+	}
+	if err := em.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(envPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "gizli-token") {
+		t.Errorf("token .env'e düz metin olarak yazılmamalı:\n%s", data)
+	}
+
+	t.Setenv(VaultPassphraseEnv, "doğru-parola")
+	reopened := NewVault(vaultPath)
+	if err := NewEnvManager(envPath).AttachVault(reopened); err != nil {
+		t.Fatal(err)
+	}
+	if token, _ := reopened.Get("K8S_TOKEN"); token != "eski" {
+		t.Errorf("kasa değişmemeli: %q", token)
+	}
+}
+
+func TestDefaultEnvFilePath(t *testing.T) {
+	configHome, work := t.TempDir(), t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(work); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	if got, want := DefaultEnvFilePath(), filepath.Join(configHome, appName, ".env"); got != want {
+		t.Errorf("çalışma dizininde .env yokken XDG yolu kullanılmalı: %q, beklenen %q", got, want)
+	}
+	if err := os.WriteFile(".env", nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := DefaultEnvFilePath(); got != ".env" {
+		t.Errorf("çalışma dizinindeki .env tercih edilmeli: %q", got)
+	}
+}
+
+func TestProfileSecretKey(t *testing.T) {
+	for _, name := range []string{"prod", "prod-eu", "prod_eu", "Prod", "a=b", "çalışma"} {
+		key := ProfileSecretKey(name, "K8S_TOKEN")
+		if !IsSecretKey(key) {
+			t.Errorf("%s gizli anahtar sayılmalı", key)
+		}
+		if _, err := parseEnvLine(key+"=x", os.LookupEnv); err != nil {
+			t.Errorf("%s .env'e yazılabilmeli: %v", key, err)
+		}
+	}
+	if ProfileSecretKey("prod-eu", "K8S_TOKEN") == ProfileSecretKey("prod_eu", "K8S_TOKEN") {
+		t.Error("farklı profiller aynı anahtarı kullanmamalı")
+	}
+	if IsSecretKey(ProfileSecretKey("prod", "API_SERVER")) {
+		t.Error("gizli olmayan profil anahtarı gizli sayılmamalı")
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  []string
+	}{
+		{"", nil},
+		{"token  --cluster prod", []string{"token", "--cluster", "prod"}},
+		{`token --cluster "prod eu" --role 'a b'`, []string{"token", "--cluster", "prod eu", "--role", "a b"}},
+		{`--name=""  say\ hi "q\"uote" 'c:\dir'`, []string{"--name=", "say hi", `q"uote`, `c:\dir`}},
+		{`["token", "--cluster", "prod eu"]`, []string{"token", "--cluster", "prod eu"}},
+		{" [] ", []string{}},
+	} {
+		got, err := SplitArgs(tc.value)
+		if err != nil {
+			t.Errorf("SplitArgs(%q) hata: %v", tc.value, err)
+			continue
+		}
+		if !slices.Equal(got, tc.want) || (got == nil) != (tc.want == nil) {
+			t.Errorf("SplitArgs(%q) = %q, beklenen %q", tc.value, got, tc.want)
+		}
+	}
+
+	for _, value := range []string{`token "prod`, `token 'prod`, `token prod\`, `["token", 1]`, `[token]`} {
+		if _, err := SplitArgs(value); err == nil {
+			t.Errorf("SplitArgs(%q) hata döndürmeliydi", value)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+)
+
+const appName = "tamerGoClient"
+
+// Source bir yapılandırma değerinin hangi katmandan geldiğini belirtir.
+// Sayısal olarak büyük olan katman küçük olanı ezer.
+type Source int
+
+const (
+	SourceNone Source = iota
+	SourceUserConfig
+	SourceDotEnv
+	SourceVault
+	SourceEnv
+	SourceFlag
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceUserConfig:
+		return i18n.T("kullanıcı config")
+	case SourceDotEnv:
+		return ".env"
+	case SourceVault:
+		return i18n.T("şifreli kasa")
+	case SourceEnv:
+		return i18n.T("ortam değişkeni")
+	case SourceFlag:
+		return i18n.T("komut satırı")
+	default:
+		return i18n.T("tanımsız")
+	}
+}
+
+// Options komut satırından gelen ve yapılandırma katmanlarını belirleyen ayarlar
+type Options struct {
+	EnvFile    string            // Proje .env dosyası
+	UserConfig string            // Kullanıcı config dosyası (boşsa XDG varsayılanı)
+	Overrides  map[string]string // --set ve kısayol bayraklarıyla verilen değerler
+}
+
+// Language UI_LANGUAGE değerini katmanlardan okur. Init'ten önce, örneğin
+// komut satırı yardımı yazılırken dili belirlemek için kullanılır; okuma
+// hataları yok sayılır.
+func (o Options) Language() string {
+	em := NewEnvManager(o.EnvFile)
+	em.Load()
+	em.AttachUserConfig(o.UserConfig)
+	em.SetOverrides(o.Overrides)
+	return em.Get("UI_LANGUAGE")
+}
+
+// DefaultConfigDir $XDG_CONFIG_HOME/tamerGoClient dizinini döndürür.
+// XDG_CONFIG_HOME tanımlı değilse ~/.config kullanılır; ev dizini
+// bulunamazsa boş döner.
+func DefaultConfigDir() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, appName)
+}
+
+// DefaultUserConfigPath $XDG_CONFIG_HOME/tamerGoClient/config.env yolunu döndürür
+func DefaultUserConfigPath() string {
+	dir := DefaultConfigDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "config.env")
+}
+
+// DefaultEnvFilePath --env-file verilmediğinde kullanılacak .env yolunu
+// döndürür. Çalışma dizininde .env varsa proje dosyası olarak o kullanılır;
+// yoksa program hangi dizinden çalıştırılırsa çalıştırılsın aynı dosyayı
+// bulmak için XDG config dizinindeki .env seçilir. Profiller, kasa ve son
+// bağlantı bilgisi bu dosyanın yanında tutulur.
+func DefaultEnvFilePath() string {
+	if _, err := os.Stat(".env"); err == nil {
+		return ".env"
+	}
+	dir := DefaultConfigDir()
+	if dir == "" {
+		return ".env"
+	}
+	return filepath.Join(dir, ".env")
+}
+
+// OverrideFlag --set KEY=VALUE bayrağının tekrar tekrar verilebilmesini sağlar
+type OverrideFlag map[string]string
+
+func (f OverrideFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for key, value := range f {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f OverrideFlag) Set(value string) error {
+	key, val, found := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if !found || key == "" {
+		return i18n.Errorf("KEY=VALUE biçiminde olmalı: %q", value)
+	}
+	f[key] = val
+	return nil
+}
+
+// SetOverrides komut satırından gelen değerleri en üst katman olarak ayarlar
+func (em *EnvManager) SetOverrides(overrides map[string]string) {
+	em.overrides = overrides
+}
+
+// AttachUserConfig kullanıcı config dosyasını en alt katman olarak yükler.
+// Dosya yoksa katman boş kalır.
+func (em *EnvManager) AttachUserConfig(path string) error {
+	if path == "" {
+		return nil
+	}
+	em.userConfig = NewEnvManager(path)
+	return em.userConfig.Load()
+}
+
+// Lookup anahtarın etkin değerini ve geldiği katmanı döndürür:
+// komut satırı > ortam değişkeni > .env (veya kasa) > kullanıcı config
+func (em *EnvManager) Lookup(key string) (string, Source) {
+	if value, exists := em.overrides[key]; exists {
+		return value, SourceFlag
+	}
+	if value, exists := os.LookupEnv(key); exists {
+		return value, SourceEnv
+	}
+	if em.vaultActive() && IsSecretKey(key) {
+		if value, exists := em.vault.Get(key); exists {
+			return value, SourceVault
+		}
+	}
+	if value, exists := em.envMap[key]; exists {
+		return value, SourceDotEnv
+	}
+	if em.userConfig != nil {
+		if value, exists := em.userConfig.envMap[key]; exists {
+			return value, SourceUserConfig
+		}
+	}
+	return "", SourceNone
+}
+
+// ShowEffectiveConfig her anahtarın etkin değerini ve kaynağını listeler
+func (em *EnvManager) ShowEffectiveConfig() {
+	fmt.Println(i18n.T("\n=== Etkin Yapılandırma ==="))
+	fmt.Println(i18n.T("Öncelik: komut satırı > ortam değişkeni > .env > kullanıcı config"))
+	i18n.Printf(".env dosyası      : %s\n", em.filePath)
+	if em.userConfig != nil {
+		i18n.Printf("Kullanıcı config  : %s\n", em.userConfig.filePath)
+	}
+	fmt.Println()
+
+	fmt.Printf("%-20s %-18s %s\n", i18n.T("ANAHTAR"), i18n.T("KAYNAK"), i18n.T("DEĞER"))
+	fmt.Println(strings.Repeat("-", 70))
+	for _, key := range em.predefinedKeys {
+		value, source := em.Lookup(key)
+		if source == SourceNone {
+			value = i18n.T("<boş>")
+		} else {
+			value = displayValue(key, value)
+		}
+		fmt.Printf("%-20s %-18s %s\n", key, source, value)
+	}
+}
@@ -0,0 +1,254 @@
+package config
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"tamerGoClient/pkg/i18n"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/term"
+)
+
+const (
+	vaultVersion    = 1
+	vaultIterations = 200000
+	vaultKeyLength  = 32 // AES-256
+
+	// profileSecretPrefix profil gizli değerlerinin anahtar öneki
+	profileSecretPrefix = "PROFILE_"
+
+	// VaultPassphraseEnv tanımlıysa kasa parolası sorulmadan bu değişkenden okunur
+	VaultPassphraseEnv = "GOCLIENT_VAULT_PASSPHRASE"
+)
+
+// SecretKeys kasada şifreli saklanan .env anahtarları
+var SecretKeys = []string{
+	"K8S_TOKEN",
+	"CLIENT_KEY_DATA",
+	"OIDC_CLIENT_SECRET",
+	"OIDC_REFRESH_TOKEN",
+	"OIDC_ID_TOKEN",
+}
+
+var ErrWrongPassphrase error = i18n.Error("kasa parolası hatalı veya dosya bozuk")
+
+// ErrVaultLocked kasa dosyası varken kilitli olduğu için gizli bir değerin
+// kaydedilemediğini belirtir
+var ErrVaultLocked error = i18n.Error("şifreli kasa kilitli, gizli değer düz metin olarak kaydedilmedi")
+
+// vaultFile diskteki şifreli kasa dosyasının formatı
+type vaultFile struct {
+	Version    int    `json:"version"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Data       []byte `json:"data"`
+}
+
+// Vault gizli değerleri parola ile türetilen anahtarla AES-GCM kullanarak saklar
+type Vault struct {
+	filePath   string
+	passphrase string
+	secrets    map[string]string
+}
+
+func NewVault(filePath string) *Vault {
+	return &Vault{
+		filePath: filePath,
+		secrets:  make(map[string]string),
+	}
+}
+
+// Exists kasa dosyasının diskte olup olmadığını döndürür
+func (v *Vault) Exists() bool {
+	_, err := os.Stat(v.filePath)
+	return err == nil
+}
+
+// Unlocked kasanın parolasının girilip girilmediğini döndürür
+func (v *Vault) Unlocked() bool {
+	return v.passphrase != ""
+}
+
+// Unlock verilen parola ile kasayı açar. Dosya yoksa boş bir kasa oluşturulur.
+func (v *Vault) Unlock(passphrase string) error {
+	if passphrase == "" {
+		return errors.New(i18n.T("kasa parolası boş olamaz"))
+	}
+	v.passphrase = passphrase
+	if err := v.Load(); err != nil {
+		v.passphrase = ""
+		return err
+	}
+	return nil
+}
+
+func (v *Vault) Load() error {
+	raw, err := os.ReadFile(v.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // Dosya yoksa boş kasa ile devam et
+		}
+		return err
+	}
+
+	var vf vaultFile
+	if err := json.Unmarshal(raw, &vf); err != nil {
+		return i18n.Errorf("kasa dosyası ayrıştırılamadı: %v", err)
+	}
+	if vf.Version != vaultVersion {
+		return i18n.Errorf("desteklenmeyen kasa sürümü: %d", vf.Version)
+	}
+
+	gcm, err := newVaultCipher(v.passphrase, vf.Salt, vf.Iterations)
+	if err != nil {
+		return err
+	}
+	plain, err := gcm.Open(nil, vf.Nonce, vf.Data, nil)
+	if err != nil {
+		return ErrWrongPassphrase
+	}
+
+	secrets := make(map[string]string)
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return i18n.Errorf("kasa içeriği ayrıştırılamadı: %v", err)
+	}
+	v.secrets = secrets
+	return nil
+}
+
+// Save kasayı her seferinde yeni salt ve nonce ile şifreleyip 0600 izinle yazar
+func (v *Vault) Save() error {
+	if !v.Unlocked() {
+		return errors.New(i18n.T("kasa kilitli"))
+	}
+
+	plain, err := json.Marshal(v.secrets)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	gcm, err := newVaultCipher(v.passphrase, salt, vaultIterations)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(vaultFile{
+		Version:    vaultVersion,
+		Iterations: vaultIterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Data:       gcm.Seal(nil, nonce, plain, nil),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(v.filePath, raw, 0600)
+}
+
+func (v *Vault) Get(key string) (string, bool) {
+	value, exists := v.secrets[key]
+	return value, exists
+}
+
+func (v *Vault) Set(key, value string) {
+	v.secrets[key] = value
+}
+
+func (v *Vault) Delete(key string) {
+	delete(v.secrets, key)
+}
+
+// Keys kasadaki anahtarları sıralı döndürür
+func (v *Vault) Keys() []string {
+	keys := make([]string, 0, len(v.secrets))
+	for key := range v.secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsSecretKey anahtarın kasada saklanması gerekip gerekmediğini döndürür.
+// Profillere ait gizli anahtarlar (bkz. ProfileSecretKey) da gizli sayılır.
+func IsSecretKey(key string) bool {
+	if rest, found := strings.CutPrefix(key, profileSecretPrefix); found {
+		if i := strings.LastIndex(rest, "__"); i >= 0 {
+			key = rest[i+2:]
+		}
+	}
+	for _, secret := range SecretKeys {
+		if key == secret {
+			return true
+		}
+	}
+	return false
+}
+
+// ProfileSecretKey bir profilin gizli değerinin .env veya kasada saklandığı
+// anahtarı döndürür (örn. PROFILE_prod__K8S_TOKEN). Farklı profillerin aynı
+// anahtara düşmemesi için profil adındaki ASCII harf, rakam, '.', '-' ve '_'
+// dışındaki baytlar %XX olarak kodlanır.
+func ProfileSecretKey(profile, key string) string {
+	var b strings.Builder
+	b.WriteString(profileSecretPrefix)
+	for i := 0; i < len(profile); i++ {
+		c := profile[i]
+		if c < utf8.RuneSelf && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)) || strings.IndexByte(".-_", c) >= 0) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	b.WriteString("__")
+	b.WriteString(key)
+	return b.String()
+}
+
+// ReadPassphrase terminalden parolayı ekrana yansıtmadan okur. Terminal
+// yoksa (pipe vb.) satır olarak okur.
+func ReadPassphrase(prompt string) (string, error) {
+	fmt.Print(prompt)
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		pass, err := term.ReadPassword(fd)
+		fmt.Println()
+		return string(pass), err
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	line, err := reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func newVaultCipher(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, iterations, vaultKeyLength, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,784 @@
+package i18n
+
+// english Türkçe mesajların İngilizce karşılıkları
+var english = map[string]string{
+	"\n%s (0 için iptal): ":                 "\n%s (0 to cancel): ",
+	"\n%s Deployment'ına ait Podlar:\n":     "\nPods of Deployment %s:\n",
+	"\n%s Service'ine Bağlı Podlar:\n":      "\nPods backing Service %s:\n",
+	"\n%s sunucusunun sertifika zinciri:\n": "\nCertificate chain of server %s:\n",
+	"\n'%s' namespace'i için yetkiler (✓: izinli, ~: yalnızca belirli isimler):\n\n": "\nPermissions for namespace '%s' (✓: allowed, ~: specific names only):\n\n",
+	"\n.env güncellendi: API_SERVER=%s, CA_CERT_PATH=%s, K8S_TOKEN (%s geçerli)\n":   "\n.env updated: API_SERVER=%s, CA_CERT_PATH=%s, K8S_TOKEN (valid for %s)\n",
+	"\n1. Kullanıcı Ayarla":                                                "\n1. Set User",
+	"\n1. Listeden Seç":                                                    "\n1. Select from List",
+	"\n1. Yeni Dosyaya Yaz":                                                "\n1. Write to New File",
+	"\n=== .env Dosyası Yönetimi ===":                                      "\n=== .env File Management ===",
+	"\n=== Bağlantı Profilleri ===":                                        "\n=== Connection Profiles ===",
+	"\n=== Bağlantı Tanılama ===":                                          "\n=== Connection Diagnostics ===",
+	"\n=== Client Sertifikası (mTLS) Bağlantı Menüsü ===":                  "\n=== Client Certificate (mTLS) Connection Menu ===",
+	"\n=== Cluster Bilgileri Menüsü ===":                                   "\n=== Cluster Information Menu ===",
+	"\n=== Deployment Detayları: %s ===\n":                                 "\n=== Deployment Details: %s ===\n",
+	"\n=== Deployment Yönetim Menüsü ===":                                  "\n=== Deployment Management Menu ===",
+	"\n=== Etkin Yapılandırma ===":                                         "\n=== Effective Configuration ===",
+	"\n=== In-Cluster Bağlantı ===":                                        "\n=== In-Cluster Connection ===",
+	"\n=== Instance Oluştur/Sil ===":                                       "\n=== Create/Delete Instances ===",
+	"\n=== Kimlik Doğrulama Yöntemleri ===":                                "\n=== Authentication Methods ===",
+	"\n=== Kimliğe Bürünme (Impersonation) ===":                            "\n=== Impersonation ===",
+	"\n=== Kubeconfig Bağlantı Menüsü ===":                                 "\n=== Kubeconfig Connection Menu ===",
+	"\n=== Kubeconfig Olarak Dışa Aktar ===":                               "\n=== Export as Kubeconfig ===",
+	"\n=== Kubernetes Yönetim Arayüzü ===":                                 "\n=== Kubernetes Management Interface ===",
+	"\n=== Pod Detayları: %s ===\n":                                        "\n=== Pod Details: %s ===\n",
+	"\n=== Pod Yönetim Menüsü ===":                                         "\n=== Pod Management Menu ===",
+	"\n=== Sertifika İnceleme ===":                                         "\n=== Certificate Inspection ===",
+	"\n=== Service Account Bağlantı Menüsü ===":                            "\n=== Service Account Connection Menu ===",
+	"\n=== Service Account Erişimi Kur (Yönetici) ===":                     "\n=== Set Up Service Account Access (Admin) ===",
+	"\n=== Service Detayları: %s ===\n":                                    "\n=== Service Details: %s ===\n",
+	"\n=== Service Yönetim Menüsü ===":                                     "\n=== Service Management Menu ===",
+	"\n=== Varsayılan Namespace ===":                                       "\n=== Default Namespace ===",
+	"\n=== Yetki İnceleme ===":                                             "\n=== Permission Review ===",
+	"\n>>> Son bağlantı geri yükleniyor: %s (atlamak için --no-restore)\n": "\n>>> Restoring last connection: %s (use --no-restore to skip)\n",
+	"\nAktif Adresler:":                                                    "\nReady Addresses:",
+	"\nAna menüye dönmek için herhangi bir tuşa basın: ":                   "\nPress any key to return to the main menu: ",
+	"\nAra Sertifika #%d:\n":                                               "\nIntermediate Certificate #%d:\n",
+	"\nBaşka sorgu yapmak için 'c' tuşuna, ana menüye dönmek için 'q' tuşuna basın: ": "\nPress 'c' for another query or 'q' to return to the main menu: ",
+	"\nBu deployment'a ait çalışan pod bulunamadı!":                                   "\nNo running pods found for this deployment!",
+	"\nBu service herhangi bir pod'a bağlı değil (selector bulunamadı)":               "\nThis service does not target any pods (no selector)",
+	"\nBu service'e bağlı çalışan pod bulunamadı!":                                    "\nNo running pods found behind this service!",
+	"\nCA Sertifikası #%d:\n":                                                         "\nCA Certificate #%d:\n",
+	"\nCanlı log takibi başladı. Çıkmak için 'q' tuşuna basın...":                     "\nFollowing logs. Press 'q' to stop...",
+	"\nConfigMap Listesi:":                                               "\nConfigMap List:",
+	"\nContainer Durumları - Pod: %s\n":                                  "\nContainer Statuses - Pod: %s\n",
+	"\nContainer Listesi:":                                               "\nContainer List:",
+	"\nContainer seçin (1-%d): ":                                         "\nSelect a container (1-%d): ",
+	"\nContainer'lar:\n":                                                 "\nContainers:\n",
+	"\nContext seçin (1-%d, 0 için current-context): ":                   "\nSelect a context (1-%d, 0 for current-context): ",
+	"\nDaemonSet Listesi:":                                               "\nDaemonSet List:",
+	"\nDeployment Bilgileri - %s:\n":                                     "\nDeployment Information - %s:\n",
+	"\nDeployment Listesi:":                                              "\nDeployment List:",
+	"\nDeployment detayları için numara girin (0 için geri dön): ":       "\nEnter a number for deployment details (0 to go back): ",
+	"\nDeployment'ı silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ": "\nAre you sure you want to delete the deployment? (%s/%s) [y/n]: ",
+	"\nDesteklenen türler: %s\n":                                         "\nSupported kinds: %s\n",
+	"\nEndpoint Bilgileri - %s:\n":                                       "\nEndpoint Information - %s:\n",
+	"\nExec plugin için: EXEC_COMMAND, EXEC_ARGS (tırnaklı veya JSON dizi), EXEC_ENV (KEY=VAL,...), EXEC_API_VERSION": "\nFor an exec plugin: EXEC_COMMAND, EXEC_ARGS (quoted or JSON array), EXEC_ENV (KEY=VAL,...), EXEC_API_VERSION",
+	"\nGerekli .env değeri:":                    "\nRequired .env value:",
+	"\nGerekli .env değerleri:":                 "\nRequired .env values:",
+	"\nGüncellenecek değerin numarası (1-%d): ": "\nNumber of the value to update (1-%d): ",
+	"\nHazır Olmayan Adresler:":                 "\nNot Ready Addresses:",
+	"\nHenüz kayıtlı profil yok.":               "\nNo saved profiles yet.",
+	"\nIngress Listesi:":                        "\nIngress List:",
+	"\nKaynak dışı URL yetkileri:":              "\nNon-resource URL permissions:",
+	"\nKayıtlı Profiller:":                      "\nSaved Profiles:",
+	"\nKimlik doğrulama yöntemi:":               "\nAuthentication method:",
+	"\nKubeconfig Context Listesi:":             "\nKubeconfig Context List:",
+	"\nLog takibi sonlandırıldı.":               "\nStopped following logs.",
+	"\nMevcut .env Değerleri:":                  "\nCurrent .env Values:",
+	"\nMevcut Deploymentlar:":                   "\nExisting Deployments:",
+	"\nMevcut Podlar:":                          "\nExisting Pods:",
+	"\nMevcut Service'ler:":                     "\nExisting Services:",
+	"\nMevcut anahtarlar:":                      "\nAvailable keys:",
+	"\nMevcut çıktı biçimi: %s\n":               "\nCurrent output format: %s\n",
+	"\nNamespace Listesi:":                      "\nNamespace List:",
+	"\nNamespace listeleme yetkiniz yok.":       "\nYou are not allowed to list namespaces.",
+	"\nNode Listesi:":                           "\nNode List:",
+	"\nParmak izini başka bir kanaldan doğruladıysanız pinlemek için 'evet' yazın: ": "\nIf you have verified the fingerprint through another channel, type 'yes' to pin it: ",
+	"\nPersistentVolume Listesi:":      "\nPersistentVolume List:",
+	"\nPersistentVolumeClaim Listesi:": "\nPersistentVolumeClaim List:",
+	"\nPinlenecek sunucu sertifikası:": "\nServer certificate to pin:",
+	"\nPod Bilgileri:\n":               "\nPod Information:\n",
+	"\nPod Listesi:":                   "\nPod List:",
+	"\nPod detayları için pod numarası girin (0 için ana menü): ":                  "\nEnter a pod number for details (0 for the main menu): ",
+	"\nPod detayları için pod numarası girin (0 için geri dön): ":                  "\nEnter a pod number for details (0 to go back): ",
+	"\nPod'u silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ":                  "\nAre you sure you want to delete the pod? (%s/%s) [y/n]: ",
+	"\nPort Yapılandırması - %s:\n":                                                "\nPort Configuration - %s:\n",
+	"\nPortlar:":                                                                   "\nPorts:",
+	"\nProfil adı: ":                                                               "\nProfile name: ",
+	"\nReplica Durumu - %s:\n":                                                     "\nReplica Status - %s:\n",
+	"\nSecret Listesi:":                                                            "\nSecret List:",
+	"\nService Bilgileri - %s:\n":                                                  "\nService Information - %s:\n",
+	"\nService Listesi:":                                                           "\nService List:",
+	"\nService detayları için numara girin (0 için geri dön): ":                    "\nEnter a number for service details (0 to go back): ",
+	"\nService'i silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ":              "\nAre you sure you want to delete the service? (%s/%s) [y/n]: ",
+	"\nSeçenekler:":                                                                "\nOptions:",
+	"\nSilmek istediğiniz deployment'ın numarasını girin (0 için iptal): ":         "\nEnter the number of the deployment to delete (0 to cancel): ",
+	"\nSilmek istediğiniz pod'un numarasını girin (0 için iptal): ":                "\nEnter the number of the pod to delete (0 to cancel): ",
+	"\nSilmek istediğiniz service'in numarasını girin (0 için iptal): ":            "\nEnter the number of the service to delete (0 to cancel): ",
+	"\nSonuç: %d başarılı, %d uyarı, %d hata\n":                                    "\nResult: %d passed, %d warnings, %d errors\n",
+	"\nSoru biçimi: <fiil> <kaynak>[/altkaynak][.grup] [namespace]":                "\nQuery format: <verb> <resource>[/subresource][.group] [namespace]",
+	"\nStatefulSet Listesi:":                                                       "\nStatefulSet List:",
+	"\nSunucu Sertifikası:":                                                        "\nServer Certificate:",
+	"\nUyarı: API sunucusu Unauthorized döndürdü, kimlik bilgileri yenileniyor...": "\nWarning: The API server returned Unauthorized, refreshing credentials...",
+	"\nUyarı: API sunucusu kural listesinin eksik olabileceğini bildirdi.":         "\nWarning: The API server reported that the rule list may be incomplete.",
+	"\nUyarı: Context değiştirmek için önce kubeconfig ile bağlanmalısınız!":       "\nWarning: You must connect with a kubeconfig before switching contexts!",
+	"\nUyarı: Kaydedilecek aktif bir bağlantı yok!":                                "\nWarning: There is no active connection to save!",
+	"\nUyarı: Sertifika CA ile doğrulanamadı: %v\n":                                "\nWarning: The certificate could not be verified against the CA: %v\n",
+	"\nUyarı: Önce bir Kubernetes cluster'ına bağlanmalısınız!":                    "\nWarning: You must connect to a Kubernetes cluster first!",
+	"\nVarsayılan Deployment YAML editörde açılacak...":                            "\nThe default Deployment YAML will be opened in the editor...",
+	"\nVarsayılan Pod YAML editörde açılacak...":                                   "\nThe default Pod YAML will be opened in the editor...",
+	"\nVarsayılan Service YAML editörde açılacak...":                               "\nThe default Service YAML will be opened in the editor...",
+	"\nYetki kuralları:":                                                           "\nPermission rules:",
+	"\nŞifreli Kasadaki Değerler:":                                                 "\nValues in the Encrypted Vault:",
+	"        İpucu: %s\n":                                                          "        Hint: %s\n",
+	"  (veya K8S_TOKEN_FILE: dönen/projected token dosyasının yolu)":               "  (or K8S_TOKEN_FILE: path to a rotating/projected token file)",
+	"  Durum       : %d gün geçerli\n":                                             "  Status      : valid for %d days\n",
+	"  Durum       : %d gün içinde sona erecek!\n":                                 "  Status      : expires in %d days!\n",
+	"  Durum       : SÜRESİ DOLMUŞ!":                                               "  Status      : EXPIRED!",
+	"  Geçerlilik  : %s - %s\n":                                                    "  Validity    : %s - %s\n",
+	"  Portlar: ":                                                                  "  Ports: ",
+	"  Seri No     : %s\n":                                                         "  Serial No   : %s\n",
+	"  c container  r yenile":                                                      "  c container  r reload",
+	" [extra: %d alan]":                                                            " [extra: %d fields]",
+	" [gruplar: %s]":                                                               " [groups: %s]",
+	" bir CA sertifikası değil":                                                    " is not a CA certificate",
+	" bir IP adresi":                                                               " is an IP address",
+	" ←/→ tür  ↑/↓ seç  Enter detay  l log  d sil  e düzenle  r yenile  ? yardım  q çık":        " ←/→ kind  ↑/↓ select  Enter details  l logs  d delete  e edit  r refresh  ? help  q quit",
+	" ↑/↓ kaydır  PgUp/PgDn sayfa  Esc geri":                                                    " ↑/↓ scroll  PgUp/PgDn page  Esc back",
+	"!! API sunucusunun kimliği doğrulanmıyor; token ve veriler ele geçirilebilir.":             "!! The API server's identity is not verified; tokens and data can be intercepted.",
+	"!! UYARI: TLS sertifika doğrulaması devre dışı bırakıldı!":                                 "!! WARNING: TLS certificate verification is disabled!",
+	"!! Yalnızca test ortamlarında kullanın. Kapatmak için: K8S_INSECURE_SKIP_TLS_VERIFY=false": "!! Use only in test environments. To turn it off: K8S_INSECURE_SKIP_TLS_VERIFY=false",
+	"%d API grubu": "%d API groups",
+	"%d gizli değer şifreli kasaya taşındı.\n":                    "%d secret values moved to the encrypted vault.\n",
+	"%s '%s' oluşturulamadı: %v":                                  "%s '%s' could not be created: %v",
+	"%s '%s' oluşturuldu\n":                                       "%s '%s' created\n",
+	"%s '%s' zaten mevcut\n":                                      "%s '%s' already exists\n",
+	"%s dosyası bulunamadı":                                       "file %s not found",
+	"%s dosyasında geçerli context tanımlı değil":                 "no current context is set in %s",
+	"%s düzenleme desteklenmiyor.":                                "Editing %s is not supported.",
+	"%s güncellendi":                                              "%s updated",
+	"%s henüz geçerli değil (%s)":                                 "%s is not valid yet (%s)",
+	"%s için dosya yolu veya PEM verisi gerekli":                  "a file path or PEM data is required for the %s",
+	"%s içinde ClusterRole bulunamadı":                            "no ClusterRole found in %s",
+	"%s okunamadı: %v":                                            "could not read %s: %v",
+	"%s oluşturuldu\n":                                            "%s created\n",
+	"%s profili":                                                  "profile %s",
+	"%s silme desteklenmiyor.":                                    "Deleting %s is not supported.",
+	"%s süresi dolmuş (%s)":                                       "%s has expired (%s)",
+	"%s sütunu için geçersiz alan: %v":                            "invalid field for column %s: %v",
+	"%s verisi PEM veya base64 formatında değil: %v":              "%s data is neither PEM nor base64: %v",
+	"%s, %s içinde sona erecek":                                   "%s, expires in %s",
+	"%s, %s tarihine kadar geçerli":                               "%s, valid until %s",
+	"%s, süresi dolmuş (%s)":                                      "%s, expired (%s)",
+	"%s/%s silindi":                                               "%s/%s deleted",
+	"%s/%s silindi\n":                                             "%s/%s deleted\n",
+	"%s/%s silinsin mi? (e/h)":                                    "Delete %s/%s? (y/n)",
+	"%s: kapanmayan tek tırnak":                                   "%s: unterminated single quote",
+	"'%s' ClusterRole'ünde kural yok":                             "ClusterRole '%s' has no rules",
+	"'%s' adlı girdiler zaten mevcut, üzerine yazılsın mı? (e/h)": "Entries named '%s' already exist, overwrite? (y/n)",
+	"'%s' adında bir profil zaten var.\n":                         "A profile named '%s' already exists.\n",
+	"'%s' context'i %s dosyasına eklendi.\n":                      "Context '%s' was added to %s.\n",
+	"'%s' context'i bulunamadı":                                   "context '%s' not found",
+	"'%s' namespace'inde":                                         "in namespace '%s'",
+	"'%s' profili artık mevcut değil":                             "profile '%s' no longer exists",
+	"'%s' profilinin %s değeri kaydedilemedi: %v":                 "profile '%s': could not save %s: %v",
+	"'%s' profili kaydedildi.\n":                                  "Profile '%s' saved.\n",
+	"'%s' profili silindi.\n":                                     "Profile '%s' deleted.\n",
+	"'%s' profili zaten var, üzerine yazılsın mı? [e/h]: ":        "Profile '%s' already exists, overwrite? [y/n]: ",
+	"'%s' profiline bağlanılamadı: %v\n":                          "Could not connect with profile '%s': %v\n",
+	"'%s' profiline geçildi: %s\n":                                "Switched to profile '%s': %s\n",
+	"'%s' profilini silmek istediğinizden emin misiniz? [e/h]: ":  "Are you sure you want to delete profile '%s'? [y/n]: ",
+	"'=' bulunamadı: %q":                                          "'=' not found: %q",
+	"(Aktif Bağlantı: %s)\n":                                      "(Active Connection: %s)\n",
+	"(Aktif Bağlantı: %s)\n\n":                                    "(Active Connection: %s)\n\n",
+	"(Aktif Bağlantı: via %s)\n":                                  "(Active Connection: via %s)\n",
+	"(Aktif Profil: %s)\n":                                        "(Active Profile: %s)\n",
+	"(Kimliğine Bürünülen: %s)\n":                                 "(Impersonating: %s)\n",
+	"(Sunucu sertifikası pin'i: %s)\n":                            "(Server certificate pin: %s)\n",
+	"(log yok)":                                                   "(no logs)",
+	", süresiz":                                                   ", no expiry",
+	"- API_SERVER: Kubernetes API sunucu adresi":                  "- API_SERVER: Kubernetes API server address",
+	"- CA_CERT_PATH veya CA_CERT_DATA: CA sertifikası (dosya yolu veya PEM)":             "- CA_CERT_PATH or CA_CERT_DATA: CA certificate (file path or PEM)",
+	"- CA_CERT_PATH: CA sertifika dosyasının yolu":                                       "- CA_CERT_PATH: path to the CA certificate file",
+	"- CLIENT_CERT_PATH veya CLIENT_CERT_DATA: Client sertifikası (dosya yolu veya PEM)": "- CLIENT_CERT_PATH or CLIENT_CERT_DATA: client certificate (file path or PEM)",
+	"- CLIENT_KEY_PATH veya CLIENT_KEY_DATA: Client anahtarı (dosya yolu veya PEM)":      "- CLIENT_KEY_PATH or CLIENT_KEY_DATA: client key (file path or PEM)",
+	"- K8S_TOKEN: Service Account token değeri":                                          "- K8S_TOKEN: Service Account token value",
+	"- KUBECONFIG_PATH: Kubeconfig dosyasının yolu":                                      "- KUBECONFIG_PATH: path to the kubeconfig file",
+	".env dosyası      : %s\n":                                                           ".env file         : %s\n",
+	".env menüsünden veya --set %s=... ile tanımlayın":                                   "Set it from the .env menu or with --set %s=...",
+	"1. Bağlantıyı Yapılandır":                                                           "1. Configure Connection",
+	"1. Bağlantıyı Yapılandır (Statik Token)":                                            "1. Configure Connection (Static Token)",
+	"1. CA Sertifikasını Göster":                                                         "1. Show CA Certificate",
+	"1. Deployment Oluştur":                                                              "1. Create Deployment",
+	"1. Genel Bilgiler":                                                                  "1. General Information",
+	"1. Kimlik Doğrulama":                                                                "1. Authentication",
+	"1. Mevcut Değerleri Göster":                                                         "1. Show Current Values",
+	"1. Namespace Listesi":                                                               "1. Namespace List",
+	"1. Namespace Yetki Matrisini Göster":                                                "1. Show Namespace Permission Matrix",
+	"1. Pod Oluştur":                                                                     "1. Create Pod",
+	"1. Pod Yönetim Menüsü":                                                              "1. Pod Management Menu",
+	"1. Profilleri Listele":                                                              "1. List Profiles",
+	"1. Service Account ile Bağlan":                                                      "1. Connect with Service Account",
+	"1. Service Oluştur":                                                                 "1. Create Service",
+	"1. Tablo (varsayılan)":                                                              "1. Table (default)",
+	"1. Varsayılan (Y manifestindeki client-access-role)":                                "1. Default (client-access-role from the Y manifest)",
+	"10. Sertifika İnceleme ve Pinleme":                                                  "10. Certificate Inspection and Pinning",
+	"10. StatefulSet Listesi":                                                            "10. StatefulSet List",
+	"11. DaemonSet Listesi":                                                              "11. DaemonSet List",
+	"11. Varsayılan Namespace Seç":                                                       "11. Select Default Namespace",
+	"12. Ana Menüye Dön":                                                                 "12. Back to Main Menu",
+	"12. Ingress Listesi":                                                                "12. Ingress List",
+	"13. Çıktı Biçimi Seç (%s)\n":                                                        "13. Select Output Format (%s)\n",
+	"2. \"Yapabilir miyim?\" Sorusu Sor":                                                 "2. Ask \"Can I?\"",
+	"2. %s ile Birleştir\n":                                                              "2. Merge into %s\n",
+	"2. .env Dosyasını Düzenle":                                                          "2. Edit .env File",
+	"2. Cluster Bilgileri":                                                               "2. Cluster Information",
+	"2. Container Durumları":                                                             "2. Container Statuses",
+	"2. Context Değiştir":                                                                "2. Switch Context",
+	"2. Deployment Sil":                                                                  "2. Delete Deployment",
+	"2. Deployment Yönetim Menüsü":                                                       "2. Deployment Management Menu",
+	"2. Değer Ekle/Güncelle":                                                             "2. Add/Update Value",
+	"2. Exec Plugin ile Bağlan":                                                          "2. Connect with Exec Plugin",
+	"2. In-Cluster Bağlantı":                                                             "2. In-Cluster Connection",
+	"2. Node Bilgileri":                                                                  "2. Node Information",
+	"2. Pod Sil":                                                                         "2. Delete Pod",
+	"2. Port Bilgileri":                                                                  "2. Port Information",
+	"2. Profile Geç":                                                                     "2. Switch Profile",
+	"2. Salt Okunur (varsayılan kaynaklar, yalnızca get/list/watch)":                     "2. Read-Only (default resources, get/list/watch only)",
+	"2. Service Account'a Bürün (namespace/isim)":                                        "2. Impersonate Service Account (namespace/name)",
+	"2. Service Sil":                                                                     "2. Delete Service",
+	"2. Sunucu Sertifikasını Göster":                                                     "2. Show Server Certificate",
+	"2. Wide (ek sütunlarla tablo)":                                                      "2. Wide (table with extra columns)",
+	"2. İsim Girerek Ayarla":                                                             "2. Set by Name",
+	"3. Deploymentları Listele":                                                          "3. List Deployments",
+	"3. Endpoint Bilgileri":                                                              "3. Endpoint Information",
+	"3. Gizli Değerleri Şifreli Kasaya Taşı":                                             "3. Move Secrets to Encrypted Vault",
+	"3. Grupları Ayarla":                                                                 "3. Set Groups",
+	"3. Instance Oluştur":                                                                "3. Create Instance",
+	"3. Kubeconfig ile Bağlan":                                                           "3. Connect with Kubeconfig",
+	"3. OIDC ile Bağlan":                                                                 "3. Connect with OIDC",
+	"3. Pod Listesi":                                                                     "3. Pod List",
+	"3. Podları Listele":                                                                 "3. List Pods",
+	"3. Replica Durumu":                                                                  "3. Replica Status",
+	"3. Service Account Erişimi Kur (Yönetici)":                                          "3. Set Up Service Account Access (Admin)",
+	"3. Service Yönetim Menüsü":                                                          "3. Service Management Menu",
+	"3. Service'leri Listele":                                                            "3. List Services",
+	"3. Son Loglar":                                                                      "3. Recent Logs",
+	"3. Sunucu Sertifikasını Pinle":                                                      "3. Pin Server Certificate",
+	"3. Tüm Namespace'ler":                                                               "3. All Namespaces",
+	"3. YAML Dosyasından (ClusterRole içeren manifest)":                                  "3. From a YAML File (manifest containing a ClusterRole)",
+	"3. Yeni Profil Ekle":                                                                "3. Add New Profile",
+	"3. Önceki Menüye Dön":                                                               "3. Back to Previous Menu",
+	"4. .env Dosyasını Düzenle":                                                          "4. Edit .env File",
+	"4. Aktif Bağlantıyı Profil Olarak Kaydet":                                           "4. Save Active Connection as Profile",
+	"4. Ana Menüye Dön":                                                                  "4. Back to Main Menu",
+	"4. Bağlı Podları Görüntüle":                                                         "4. View Backing Pods",
+	"4. Canlı Log Takibi":                                                                "4. Follow Logs",
+	"4. Client Sertifikası (mTLS)":                                                       "4. Client Certificate (mTLS)",
+	"4. Client Sertifikası (mTLS) ile Bağlan":                                            "4. Connect with Client Certificate (mTLS)",
+	"4. Etkin Yapılandırmayı ve Kaynaklarını Göster":                                     "4. Show Effective Configuration and Sources",
+	"4. Extra Alan Ekle":                                                                 "4. Add Extra Field",
+	"4. Pin'i Kaldır":                                                                    "4. Remove Pin",
+	"4. Service Listesi":                                                                 "4. Service List",
+	"4. Seçimi Kalıcı Olarak Kaydet (K8S_NAMESPACE)":                                     "4. Save Selection Permanently (K8S_NAMESPACE)",
+	"4. Tam Ekran Arayüz":                                                                "4. Full-Screen Interface",
+	"4. Önceki Menüye Dön":                                                               "4. Back to Previous Menu",
+	"4. İlgili Podları Görüntüle":                                                        "4. View Related Pods",
+	"5. Bağlantı Profilleri":                                                             "5. Connection Profiles",
+	"5. Bürünmeyi Aç/Kapat":                                                              "5. Toggle Impersonation",
+	"5. Deployment Listesi":                                                              "5. Deployment List",
+	"5. Profil Sil":                                                                      "5. Delete Profile",
+	"5. Çıkış":                                                                           "5. Exit",
+	"5. Önceki Menüye Dön":                                                               "5. Back to Previous Menu",
+	"6. Ayarları Temizle":                                                                "6. Clear Settings",
+	"6. ConfigMap Listesi":                                                               "6. ConfigMap List",
+	"6. Deployment Listesine Dön":                                                        "6. Back to Deployment List",
+	"6. Service Listesine Dön":                                                           "6. Back to Service List",
+	"6. Yetkilerimi İncele":                                                              "6. Review My Permissions",
+	"6. Önceki Menü":                                                                     "6. Previous Menu",
+	"6. Önceki Menüye Dön":                                                               "6. Back to Previous Menu",
+	"7. Aktif Bağlantıyı Kubeconfig Olarak Dışa Aktar":                                   "7. Export Active Connection as Kubeconfig",
+	"7. Secret Listesi":                                                                  "7. Secret List",
+	"7. Önceki Menüye Dön":                                                               "7. Back to Previous Menu",
+	"8. Kimliğe Bürünme (Impersonation)":                                                 "8. Impersonation",
+	"8. PersistentVolume Listesi":                                                        "8. PersistentVolume List",
+	"8. Önceki Menüye Dön":                                                               "8. Back to Previous Menu",
+	"9. Bağlantı Tanılama":                                                               "9. Connection Diagnostics",
+	"9. PersistentVolumeClaim Listesi":                                                   "9. PersistentVolumeClaim List",
+	"<bilinmiyor>":                                                                       "<unknown>",
+	"<boş>":                                                                              "<empty>",
+	"<yok>":                                                                              "<none>",
+	">>> Bağlandı: %s\n":                                                                 ">>> Connected: %s\n",
+	">>> Otomatik bağlantı başarısız: %v\n":                                              ">>> Automatic connection failed: %v\n",
+	"ANAHTAR": "KEY",
+	"API sunucusu normalde https üzerinden hizmet verir": "The API server is normally served over https",
+	"API sunucusuna ulaşılamadı":                         "The API server could not be reached",
+	"API sunucusunun durumunu kontrol edin":              "Check the health of the API server",
+	"API_SERVER değerini ezer":                           "Overrides API_SERVER",
+	"API_SERVER tanımlı değil ve aktif bağlantı yok":     "API_SERVER is not set and there is no active connection",
+	"Aktif bağlantı '%s' profili olarak kaydedildi.\n":   "The active connection was saved as profile '%s'.\n",
+	"Aktif: %s\n": "Active: %s\n",
+	"Artık 'Service Account ile Bağlan' menüsünden bağlanabilirsiniz.":                     "You can now connect from the 'Connect with Service Account' menu.",
+	"Ayrıntılı kontrol için Kimlik Doğrulama menüsünden 'Bağlantı Tanılama'yı çalıştırın.": "For a detailed check, run 'Connection Diagnostics' from the Authentication menu.",
+	"Açık": "On",
+	"Açılışta son bağlantıyı otomatik geri yükleme":                    "Do not restore the last connection on startup",
+	"Ağ erişimini, proxy ayarlarını ve güvenlik duvarını kontrol edin": "Check network access, proxy settings and the firewall",
+	"Bağlantı ayarları": "Connection settings",
+	"Bağlantı tanılamasını çalıştırıp çık (hata varsa çıkış kodu 1)": "Run connection diagnostics and exit (exit code 1 on failure)",
+	"Bu service için aktif endpoint bulunamadı":                      "No active endpoints found for this service",
+	"CA Sertifika dosya yolu: ":                                      "CA certificate file path: ",
+	"CA ile doğrulama: BAŞARISIZ (%v)\n":                             "Verification against CA: FAILED (%v)\n",
+	"CA ile doğrulama: Başarılı":                                     "Verification against CA: Successful",
+	"CA sertifikası":                                                 "CA certificate",
+	"CA sertifikası kaydedildi: %s\n":                                "CA certificate saved: %s\n",
+	"CA sertifikası okunamadı: %v":                                   "could not read CA certificate: %v",
+	"CA sertifikası yazılamadı: %v":                                  "could not write CA certificate: %v",
+	"CA sertifikası yok (sistem sertifika deposu kullanılıyor).":     "No CA certificate (the system certificate store is used).",
+	"CA verisi ayrıştırılamadı":                                      "could not parse CA data",
+	"CA verisi dosyaya gömülsün mü? (e/h)":                           "Embed CA data in the file? (y/n)",
+	"CA_CERT_PATH değerini ezer":                                     "Overrides CA_CERT_PATH",
+	"CA_CERT_PATH doğru cluster CA'sını göstermeli; gerekirse K8S_TLS_SERVER_NAME ayarlayın": "CA_CERT_PATH must point to the correct cluster CA; set K8S_TLS_SERVER_NAME if needed",
+	"CA_CERT_PATH tanımlı değil":                      "CA_CERT_PATH is not set",
+	"Client anahtar dosya yolu: ":                     "Client key file path: ",
+	"Client sertifika dosya yolu: ":                   "Client certificate file path: ",
+	"Client sertifikası ile bağlantı başarılı!":       "Connected successfully with client certificate!",
+	"Client sertifikası ile bağlantı başarısız: %v\n": "Connection with client certificate failed: %v\n",
+	"Cluster'ın güncel CA sertifikasını alın":         "Obtain the cluster's current CA certificate",
+	"Cluster/kullanıcı/context adı":                   "Cluster/user/context name",
+	"ClusterRole '%s' güncellendi\n":                  "ClusterRole '%s' updated\n",
+	"ClusterRole '%s' güncellenemedi: %v":             "could not update ClusterRole '%s': %v",
+	"ClusterRoleBinding '%s' güncellendi\n":           "ClusterRoleBinding '%s' updated\n",
+	"ClusterRoleBinding '%s' güncellenemedi: %v":      "could not update ClusterRoleBinding '%s': %v",
+	"ClusterRoleBinding '%s' okunamadı: %v":           "could not read ClusterRoleBinding '%s': %v",
+	"ConfigMap listesi alınamadı: %v\n":               "Could not get ConfigMap list: %v\n",
+	"Container adı":                                   "Container name",
+	"Context değiştirildi: %s\n":                      "Context switched: %s\n",
+	"Context değiştirilemedi: %v\n":                   "Could not switch context: %v\n",
+	"DEĞER":                                           "VALUE",
+	"DNS çözümleme":                                   "DNS resolution",
+	"DURUM":                                           "STATUS",
+	"DaemonSet listesi alınamadı: %v\n":               "Could not get DaemonSet list: %v\n",
+	"Deployment başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n":                  "Deployment created successfully! Details will be shown in %v...\n",
+	"Deployment başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...":       "Deployment deleted successfully! Please wait a moment for the effects to show...",
+	"Deployment bilgileri alınamadı: %v\n":                                                     "Could not get deployment information: %v\n",
+	"Deployment detayları alınamadı: %v\n":                                                     "Could not get deployment details: %v\n",
+	"Deployment listesi alınamadı: %v\n":                                                       "Could not get deployment list: %v\n",
+	"Deployment oluşturma hatası: %v\n":                                                        "Deployment creation error: %v\n",
+	"Deployment silinemedi: %v\n":                                                              "Could not delete deployment: %v\n",
+	"Değer başarıyla kaydedildi!":                                                              "Value saved successfully!",
+	"Değerlendirme hatası: %s\n":                                                               "Evaluation error: %s\n",
+	"Değişiklik yapılmadı.":                                                                    "No changes made.",
+	"Dosya PEM formatında olmalı; base64 kodlu ca.crt değerini 'base64 -d' ile çözüp kaydedin": "The file must be PEM; decode the base64 ca.crt value with 'base64 -d' and save it",
+	"Dosya mevcut, üzerine yazılsın mı? (e/h)":                                                 "File exists, overwrite? (y/n)",
+	"Dosya yolu": "File path",
+	"Dosya yolunu ve okuma iznini kontrol edin": "Check the file path and read permission",
+	"Durum: %s\n":                                                "Status: %s\n",
+	"Durum: Running (Başlangıç: %s)\n":                           "Status: Running (Started: %s)\n",
+	"Durum: Terminated (Sebep: %s, Kod: %d)\n":                   "Status: Terminated (Reason: %s, Code: %d)\n",
+	"Durum: Waiting (Sebep: %s)\n":                               "Status: Waiting (Reason: %s)\n",
+	"EVET: %s %s için '%s' yetkiniz var.\n":                      "YES: %s, %s: '%s' is allowed.\n",
+	"Editör hatası: %v\n":                                        "Editor error: %v\n",
+	"Endpoint bilgileri alınamadı: %v\n":                         "Could not get endpoint information: %v\n",
+	"Enter           Seçili kaynağın detayını (YAML) göster":     "Enter           Show details (YAML) of the selected resource",
+	"Esc, q          Görünümden çık (listede q arayüzü kapatır)": "Esc, q          Leave the view (q in the list quits)",
+	"Etkin yapılandırmayı ve kaynaklarını gösterip çık":          "Show the effective configuration and its sources, then exit",
+	"Events alınamadı: %v\n":                                     "Could not get events: %v\n",
+	"Exec plugin hatası: %v\n":                                   "Exec plugin error: %v\n",
+	"Exec plugin ile bağlantı başarılı! Token süresi dolduğunda plugin otomatik yeniden çalıştırılacak.": "Connected successfully with exec plugin! The plugin will be rerun automatically when the token expires.",
+	"Exec plugin ile bağlantı başarısız: %v\n":                                                           "Connection with exec plugin failed: %v\n",
+	"Exec plugin komutu: ":                 "Exec plugin command: ",
+	"Extra alan (anahtar=değer1,değer2): ": "Extra field (key=value1,value2): ",
+	"Geçersiz seçim!":                      "Invalid choice!",
+	"Geçersiz tuş! Başka sorgu yapmak için 'c', ana menüye dönmek için 'q' tuşuna basın: ": "Invalid key! Press 'c' for another query or 'q' to return to the main menu: ",
+	"Geçilecek profilin numarası":                  "Number of the profile to switch to",
+	"Gruplar (virgülle ayrılmış, boş = temizle): ": "Groups (comma-separated, empty = clear): ",
+	"Gruplar: %s\n": "Groups: %s\n",
+	"HAYIR: %s %s için '%s' yetkiniz yok.\n": "NO: %s, %s: '%s' is not allowed.\n",
+	"HEDEF":                                  "TARGET",
+	"Hata: %s":                               "Error: %s",
+	"Hata: %s \"%s\" bulunamadı\n":           "Error: %s \"%s\" not found\n",
+	"Hata: %s ayrıştırılamadı: %v\n":         "Error: could not parse %s: %v\n",
+	"Hata: %s güncellenemedi: %v":            "Error: could not update %s: %v",
+	"Hata: %s listesi alınamadı: %v":         "Error: could not list %s: %v",
+	"Hata: %s listesi alınamadı: %v\n":       "Error: could not list %s: %v\n",
+	"Hata: %s okunamadı: %v\n":               "Error: could not read %s: %v\n",
+	"Hata: %s kaydedilemedi: %v\n":           "Error: could not save %s: %v\n",
+	"Hata: %s oluşturulamadı: %v\n":          "Error: could not create %s: %v\n",
+	"Hata: %s/%s silinemedi: %v":             "Error: could not delete %s/%s: %v",
+	"Hata: %s/%s silinemedi: %v\n":           "Error: could not delete %s/%s: %v\n",
+	"Hata: %v":                               "Error: %v",
+	"Hata: %v\n":                             "Error: %v\n",
+	"Hata: .env dosyası kaydedilemedi: %v\n": "Error: could not save .env file: %v\n",
+	"Hata: Bağlantı kurulamadı: %v\n":        "Error: could not connect: %v\n",
+	"Hata: Bu işlem için önce yetkili bir kubeconfig ile bağlanmalısınız!":             "Error: you must connect with a privileged kubeconfig for this operation!",
+	"Hata: Bürünme ayarı uygulanamadı: %v\n":                                           "Error: could not apply impersonation: %v\n",
+	"Hata: CA sertifikası ayrıştırılamadı: %v\n":                                       "Error: could not parse CA certificate: %v\n",
+	"Hata: Değerler kaydedilemedi: %v\n":                                               "Error: could not save values: %v\n",
+	"Hata: Düzenlenen dosya okunamadı: %v":                                             "Error: could not read the edited file: %v",
+	"Hata: Editör çalıştırılamadı: %v":                                                 "Error: could not run the editor: %v",
+	"Hata: Fiil ve kaynak belirtilmelidir!":                                            "Error: a verb and a resource must be given!",
+	"Hata: Geçersiz namespace adı: %s\n":                                               "Error: invalid namespace name: %s\n",
+	"Hata: Geçersiz süre!":                                                             "Error: invalid duration!",
+	"Hata: Geçici dosya oluşturulamadı: %v":                                            "Error: could not create temporary file: %v",
+	"Hata: Geçici dosya yazılamadı: %v":                                                "Error: could not write temporary file: %v",
+	"Hata: Kasa dosyası mevcut ama kilitli. Programı yeniden başlatıp parolayı girin.": "Error: the vault file exists but is locked. Restart the program and enter the passphrase.",
+	"Hata: Kasa oluşturulamadı: %v\n":                                                  "Error: could not create vault: %v\n",
+	"Hata: Kasa yapılandırılmamış.":                                                    "Error: no vault is configured.",
+	"Hata: Loglar alınamadı: %v":                                                       "Error: could not get logs: %v",
+	"Hata: Loglar alınamadı: %v\n":                                                     "Error: could not get logs: %v\n",
+	"Hata: Namespace listesi alınamadı: %v\n":                                          "Error: could not get namespace list: %v\n",
+	"Hata: Parola okunamadı: %v\n":                                                     "Error: could not read passphrase: %v\n",
+	"Hata: Parolalar eşleşmiyor!":                                                      "Error: passphrases do not match!",
+	"Hata: Profil kaydedilemedi: %v\n":                                                 "Error: could not save profile: %v\n",
+	"Hata: Profil silinemedi: %v\n":                                                    "Error: could not delete profile: %v\n",
+	"Hata: YAML okunamadı: %v\n":                                                       "Error: could not read YAML: %v\n",
+	"Hata: Yedek alınamadı: %v\n":                                                      "Error: could not create backup: %v\n",
+	"Hata: Yetki kuralları alınamadı: %v\n":                                            "Error: could not get permission rules: %v\n",
+	"Hata: Yetki sorgulanamadı: %v\n":                                                  "Error: could not query permission: %v\n",
+	"Hata: anahtar=değer biçiminde girilmelidir!":                                      "Error: must be entered as key=value!",
+	"Hata: bilinmeyen komut: %s\n\n":                                                   "Error: unknown command: %s\n\n",
+	"Hata: namespace/isim biçiminde girilmelidir!":                                     "Error: must be entered as namespace/name!",
+	"Hata: Çıktı oluşturulamadı: %v\n":                                                 "Error: could not render output: %v\n",
+	"Hata: Önce bir bağlantı kurmalısınız!":                                            "Error: you must connect first!",
+	"Hata: Önce bürünülecek kullanıcıyı ayarlayın!":                                    "Error: set the user to impersonate first!",
+	"Henüz hiç değer yok.":                                                             "No values yet.",
+	"Herhangi bir anahtarı ezer (KEY=VALUE, tekrar verilebilir)":                       "Overrides any key (KEY=VALUE, repeatable)",
+	"Home/End, g/G   Listenin başına/sonuna git":                                       "Home/End, g/G   Go to the start/end of the list",
+	"Host adını, /etc/hosts ve DNS ayarlarını kontrol edin":                            "Check the host name, /etc/hosts and DNS settings",
+	"In-cluster bağlantı başarılı!":                                                    "In-cluster connection successful!",
+	"In-cluster bağlantı başarısız: %v\n":                                              "In-cluster connection failed: %v\n",
+	"Ingress listesi alınamadı: %v\n":                                                  "Could not get Ingress list: %v\n",
+	"JWT payload ayrıştırılamadı: %v":                                                  "could not parse JWT payload: %v",
+	"JWT payload çözülemedi: %v":                                                       "could not decode JWT payload: %v",
+	"K8S_NAMESPACE=%s kaydedildi.\n":                                                   "K8S_NAMESPACE=%s saved.\n",
+	"K8S_PROXY_URL, K8S_QPS, K8S_TIMEOUT vb. değerleri düzeltin":                       "Fix K8S_PROXY_URL, K8S_QPS, K8S_TIMEOUT and similar values",
+	"K8S_TOKEN veya K8S_TOKEN_FILE tanımlı değil":                                      "Neither K8S_TOKEN nor K8S_TOKEN_FILE is set",
+	"KAYNAK":                         "SOURCE",
+	"KEY=VALUE biçiminde olmalı: %q": "must be in KEY=VALUE form: %q",
+	"KUBECONFIG_PATH değerini ezer":  "Overrides KUBECONFIG_PATH",
+	"Kalan adımlar":                  "Remaining steps",
+	"Kapalı":                         "Off",
+	"Kaynak bulunamadı.":             "No resources found.",
+	"Komut verilmezse etkileşimli menü açılır.\n\nKomutlar:\n": "Without a command the interactive menu opens.\n\nCommands:\n",
+	"Kubeconfig dosya yolu [varsayılan: %s]: ":                 "Kubeconfig file path [default: %s]: ",
+	"Kubeconfig ile bağlantı başarılı! (%s, context: %s)\n":    "Connected successfully with kubeconfig! (%s, context: %s)\n",
+	"Kubeconfig ile bağlantı başarısız: %v\n":                  "Connection with kubeconfig failed: %v\n",
+	"Kubeconfig yazıldı: %s\n":                                 "Kubeconfig written: %s\n",
+	"Kullanıcı adı: ":                                          "User name: ",
+	"Kullanıcı config  : %s\n":                                 "User config       : %s\n",
+	"Kullanıcı config dosyasının yolu":                         "Path to the user config file",
+	"Kullanıcı: %s\n":                                          "User: %s\n",
+	"Kullanılan bağlantı: %s\n\n":                              "Connection in use: %s\n\n",
+	"Kullanım: %s [seçenekler] <komut> [argümanlar]\n":         "Usage: %s [options] <command> [arguments]\n",
+	"Kullanım: kubectl --context %s get pods\n":                "Usage: kubectl --context %s get pods\n",
+	"Kullanım: kubectl --kubeconfig %s get pods\n":             "Usage: kubectl --kubeconfig %s get pods\n",
+	"Kısayollar":                                       "Shortcuts",
+	"Label selector (örn. app=web)":                    "Label selector (e.g. app=web)",
+	"Label selector oluşturulamadı: %v\n":              "Could not build label selector: %v\n",
+	"Listeler tüm namespace'leri kapsayacak.":          "Lists will cover all namespaces.",
+	"Log stream açılamadı: %v\n":                       "Could not open log stream: %v\n",
+	"Loglar yalnızca pod listesinde görüntülenebilir.": "Logs can only be shown from the pod list.",
+	"Loglar: %s/%s [%s]":                               "Logs: %s/%s [%s]",
+	"Logları canlı takip et":                           "Follow the logs",
+	"MESAJ":                                            "MESSAGE",
+	"Manifest dosyası":                                 "Manifest file",
+	"Mevcut dosyanın yedeği alındı: %s.bak\n":          "Backed up the existing file: %s.bak\n",
+	"NTP ile sistem saatini senkronize edin; token doğrulaması başarısız olabilir":          "Synchronize the system clock with NTP; token validation may fail",
+	"Namespace (varsayılan: default): ":                                                     "Namespace (default: default): ",
+	"Namespace listeleme yetkiniz yok; ismi '2. İsim Girerek Ayarla' ile girin.":            "You are not allowed to list namespaces; enter the name with '2. Set by Name'.",
+	"Namespace listesi alınamadı: %v\n":                                                     "Could not get namespace list: %v\n",
+	"Namespace numarası (0 için iptal): ":                                                   "Namespace number (0 to cancel): ",
+	"Namespace'teki Role ve RoleBinding'i kontrol edin":                                     "Check the Role and RoleBinding in the namespace",
+	"Node listesi alınamadı: %v\n":                                                          "Could not get node list: %v\n",
+	"OIDC ile bağlantı başarılı! ID token süresi dolduğunda refresh token ile yenilenecek.": "Connected successfully with OIDC! The ID token will be renewed with the refresh token when it expires.",
+	"OIDC ile bağlantı başarısız: %v\n":                                                     "Connection with OIDC failed: %v\n",
+	"OIDC için: OIDC_ISSUER_URL, OIDC_CLIENT_ID, OIDC_CLIENT_SECRET, OIDC_REFRESH_TOKEN":    "For OIDC: OIDC_ISSUER_URL, OIDC_CLIENT_ID, OIDC_CLIENT_SECRET, OIDC_REFRESH_TOKEN",
+	"OLUŞTURULMA":                                            "CREATED",
+	"Oluşturulma: %s\n":                                      "Created: %s\n",
+	"Oluşturulma: Bilgi yok":                                 "Created: unknown",
+	"PEM sertifika bulunamadı":                               "no PEM certificate found",
+	"POD SAYISI":                                             "POD COUNT",
+	"Parolayı tekrar girin: ":                                "Re-enter passphrase: ",
+	"PersistentVolume listesi alınamadı: %v\n":               "Could not get PersistentVolume list: %v\n",
+	"PersistentVolumeClaim listesi alınamadı: %v\n":          "Could not get PersistentVolumeClaim list: %v\n",
+	"PgUp/PgDn       Sayfa sayfa ilerle":                     "PgUp/PgDn       Move page by page",
+	"Pin kaldırıldı. Bir sonraki bağlantıda geçerli olacak.": "Pin removed. Takes effect on the next connection.",
+	"Pin kaydedildi. Sunucu sertifikası değişirse bağlantı reddedilecek.": "Pin saved. The connection will be refused if the server certificate changes.",
+	"Pin kontrolü: EŞLEŞMİYOR! Sunucu sertifikası değişmiş olabilir.":     "Pin check: MISMATCH! The server certificate may have changed.",
+	"Pin kontrolü: Eşleşiyor":                     "Pin check: Matches",
+	"Plugin kimlik bilgisi geçerlilik sonu: %s\n": "Plugin credential expires at: %s\n",
+	"Pod Adı: %s\n":                               "Pod Name: %s\n",
+	"Pod başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n":            "Pod created successfully! Details will be shown in %v...\n",
+	"Pod başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...": "Pod deleted successfully! Please wait a moment for the effects to show...",
+	"Pod bilgileri alınamadı: %v\n":                                               "Could not get pod information: %v\n",
+	"Pod detayları alınamadı: %v\n":                                               "Could not get pod details: %v\n",
+	"Pod listesi alınamadı: %v\n":                                                 "Could not get pod list: %v\n",
+	"Pod oluşturma hatası: %v\n":                                                  "Pod creation error: %v\n",
+	"Pod silinemedi: %v\n":                                                        "Could not delete pod: %v\n",
+	"Pod'da container bulunamadı.":                                                "The pod has no containers.",
+	"Podlar alınamadı: %v\n":                                                      "Could not get pods: %v\n",
+	"Profil adı boş olamaz!":                                                      "Profile name cannot be empty!",
+	"Programdan çıkılıyor...":                                                     "Exiting the program...",
+	"Proje .env dosyasının yolu; profiller ve kasa bu dosyanın yanında tutulur":   "Path to the project .env file; profiles and the vault are kept next to it",
+	"QoS Sınıfı: %s\n":                                                            "QoS Class: %s\n",
+	"SEBEP":                                                                       "REASON",
+	"Saat farkı":                                                                  "Clock skew",
+	"Sebep: %s\n":                                                                 "Reason: %s\n",
+	"Secret listesi alınamadı: %v\n":                                              "Could not get Secret list: %v\n",
+	"Service Account (namespace/isim): ":                                          "Service Account (namespace/name): ",
+	"Service Account ile bağlantı başarılı!":                                      "Connected successfully with Service Account!",
+	"Service Account ile bağlantı başarısız: %v\n":                                "Connection with Service Account failed: %v\n",
+	"Service account'u client-access-role'e bağlayan ClusterRoleBinding'i kontrol edin (Y manifesti) veya K8S_NAMESPACE tanımlayın": "Check the ClusterRoleBinding that binds the service account to client-access-role (Y manifest) or set K8S_NAMESPACE",
+	"Service başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n":                                                          "Service created successfully! Details will be shown in %v...\n",
+	"Service başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...":                                               "Service deleted successfully! Please wait a moment for the effects to show...",
+	"Service bilgileri alınamadı: %v\n":                         "Could not get service information: %v\n",
+	"Service detayları alınamadı: %v\n":                         "Could not get service details: %v\n",
+	"Service listesi alınamadı: %v\n":                           "Could not get service list: %v\n",
+	"Service oluşturma hatası: %v\n":                            "Service creation error: %v\n",
+	"Service silinemedi: %v\n":                                  "Could not delete service: %v\n",
+	"Seçiminiz (1-12): ":                                        "Your choice (1-12): ",
+	"Seçiminiz (1-2)":                                           "Your choice (1-2)",
+	"Seçiminiz (1-3)":                                           "Your choice (1-3)",
+	"Seçiminiz (1-3): ":                                         "Your choice (1-3): ",
+	"Seçiminiz (1-4): ":                                         "Your choice (1-4): ",
+	"Seçiminiz (1-5): ":                                         "Your choice (1-5): ",
+	"Seçiminiz (1-6): ":                                         "Your choice (1-6): ",
+	"Seçiminiz (1-7): ":                                         "Your choice (1-7): ",
+	"Seçiminiz (1-8): ":                                         "Your choice (1-8): ",
+	"Silinecek profilin numarası":                               "Number of the profile to delete",
+	"Silme iptal edildi.":                                       "Deletion cancelled.",
+	"Sistem saatini kontrol edin":                               "Check the system clock",
+	"Soru: ":                                                    "Query: ",
+	"StatefulSet listesi alınamadı: %v\n":                       "Could not get StatefulSet list: %v\n",
+	"Sunucu sertifikası yerine cluster CA'sını kullanın":        "Use the cluster CA instead of the server certificate",
+	"Sunucuya erişiliyor ancak bu uç nokta için yetki yok":      "The server is reachable but access to this endpoint is not permitted",
+	"Sütunlar (örn. NAME:.metadata.name,NODE:.spec.nodeName): ": "Columns (e.g. NAME:.metadata.name,NODE:.spec.nodeName): ",
+	"TLS sertifika doğrulaması KAPALI (K8S_INSECURE_SKIP_TLS_VERIFY=true). Bağlantı ortadaki adam saldırılarına açık!": "TLS certificate verification is OFF (K8S_INSECURE_SKIP_TLS_VERIFY=true). The connection is open to man-in-the-middle attacks!",
+	"Token %s içinde sona erecek (%s).":                                                    "The token expires in %s (%s).",
+	"Token geçerlilik sonu: %s\n":                                                          "Token expires at: %s\n",
+	"Token geçerlilik süresi (saat)":                                                       "Token lifetime (hours)",
+	"Token geçersiz veya süresi dolmuş":                                                    "The token is invalid or expired",
+	"Token oluşturuldu, geçerlilik sonu: %s\n":                                             "Token created, expires at: %s\n",
+	"Token süresi doldu (%s)! İlk istekte yeniden bağlanılmaya çalışılacak.":               "The token has expired (%s)! Reconnecting will be attempted on the first request.",
+	"Token'ı 'kubectl create token client-access-sa -n client-access' ile üretebilirsiniz": "You can create a token with 'kubectl create token client-access-sa -n client-access'",
+	"Token'ı yakında yenileyin":                                                            "Renew the token soon",
+	"Token'ın discovery yetkisi olduğundan emin olun":                                      "Make sure the token is allowed to use discovery",
+	"Token'ın eksiksiz ve tırnaksız kopyalandığından emin olun":                            "Make sure the token was copied completely and without quotes",
+	"Tüm namespace'ler":                                                                    "All namespaces",
+	"TİP":                                                                                  "TYPE",
+	"UYARI: %s\n":                                                                          "WARNING: %s\n",
+	"Uyarı: %s\n":                                                                          "Warning: %s\n",
+	"Uyarı: %s değeri %s tarafından ezildiği için bu değer kullanılmayacak.\n":                         "Warning: %s is overridden by %s, so this value will not be used.\n",
+	"Uyarı: %s yalnızca bu oturumda kullanılacak: %v\n":                                                "Warning: %s will only be used for this session: %v\n",
+	"Uyarı: .env dosyası kaydedilemedi: %v\n":                                                          "Warning: could not save .env file: %v\n",
+	"Uyarı: .env dosyası yüklenirken hata oluştu: %v\n":                                                "Warning: error while loading .env file: %v\n",
+	"Uyarı: Dosya bearer token içerecek; paylaşırken dikkatli olun.":                                   "Warning: the file will contain a bearer token; be careful when sharing it.",
+	"Uyarı: K8S_TOKEN_FILE tanımlı; Service Account bağlantısında token dosyası öncelikli olacak.":     "Warning: K8S_TOKEN_FILE is set; the token file takes precedence for Service Account connections.",
+	"Uyarı: Kaynak grubu bulunamadı, core grup varsayılıyor: %v\n":                                     "Warning: resource group not found, assuming the core group: %v\n",
+	"Uyarı: Kullanıcı config dosyası yüklenirken hata oluştu: %v\n":                                    "Warning: error while loading the user config file: %v\n",
+	"Uyarı: Profil dosyasındaki gizli değerler taşınamadı: %v\n":                                       "Warning: could not move secrets out of the profile file: %v\n",
+	"Uyarı: Profil dosyası yüklenirken hata oluştu: %v\n":                                              "Warning: error while loading the profile file: %v\n",
+	"Uyarı: Son bağlantı bilgisi kaydedilemedi: %v\n":                                                  "Warning: could not save the last connection: %v\n",
+	"Uyarı: Token değişmedi; yeni token'ı .env'e veya profile kaydedin ya da K8S_TOKEN_FILE kullanın.": "Warning: the token did not change; save a new token to .env or the profile, or use K8S_TOKEN_FILE.",
+	"Uyarı: Tüm namespace'leri listeleme yetkiniz yok, '%s' namespace'ine geçildi.\n":                  "Warning: you are not allowed to list all namespaces, switched to namespace '%s'.\n",
+	"Uyarı: Şifreli kasa açılamadı, gizli değerler kullanılamayacak: %v\n":                             "Warning: could not open the encrypted vault, secret values will not be available: %v\n",
+	"Varsayılan namespace: %s\n":                                                                       "Default namespace: %s\n",
+	"Y manifestindeki ClusterRole ve binding'i uygulayın":                                              "Apply the ClusterRole and binding from the Y manifest",
+	"YAML ayrıştırılamadı: %v":                                                                         "could not parse YAML: %v",
+	"YAML dosyası (standart giriş için -)":                                                             "YAML file (- for standard input)",
+	"YAML parse hatası: %v\n":                                                                          "YAML parse error: %v\n",
+	"Yalnızca son N satır (-1: tümü)":                                                                  "Only the last N lines (-1: all)",
+	"Yedek (cluster geneli yetki yoksa): %s\n":                                                         "Fallback (without cluster-wide permission): %s\n",
+	"Yeni değer (%s): ":    "New value (%s): ",
+	"Yeni kasa parolası: ": "New vault passphrase: ",
+	"Yeni token üretin veya K8S_TOKEN_FILE ile dönen bir token kullanın": "Create a new token or use a rotating token via K8S_TOKEN_FILE",
+	"Yeniden bağlanılamadı: %v\n":                                        "Could not reconnect: %v\n",
+	"Yeniden bağlanıldı: %s\n":                                           "Reconnected: %s\n",
+	"YÖNTEM":                                                             "METHOD",
+	"ZAMAN":                                                              "TIME",
+	"Zaten '%s' context'i kullanılıyor.\n":                               "Context '%s' is already in use.\n",
+	"aktif bağlantıda dışa aktarılabilecek kimlik bilgisi yok": "the active connection has no credentials that can be exported",
+	"ayrıştırılamadı: ": "could not parse: ",
+	"bağlantı bilgisi bulunamadı: önce menüden bağlanın ya da KUBECONFIG_PATH veya API_SERVER/K8S_TOKEN/CA_CERT_PATH tanımlayın": "no connection information: connect from the menu first or set KUBECONFIG_PATH or API_SERVER/K8S_TOKEN/CA_CERT_PATH",
+	"API_SERVER verildi ancak K8S_TOKEN/K8S_TOKEN_FILE veya CA_CERT_PATH eksik":                                                  "API_SERVER was given but K8S_TOKEN/K8S_TOKEN_FILE or CA_CERT_PATH is missing",
+	"bağlantı testi başarısız: %v":                                     "connection test failed: %v",
+	"bilinmeyen bağlantı yöntemi: %s":                                  "unknown connection method: %s",
+	"client anahtarı":                                                  "client key",
+	"client oluşturulamadı: %v":                                        "could not create client: %v",
+	"client sertifikası":                                               "client certificate",
+	"cluster CA sertifikası bulunamadı: %v":                            "cluster CA certificate not found: %v",
+	"context yapılandırması oluşturulamadı: %v":                        "could not build context configuration: %v",
+	"current-context bu context olarak ayarlansın mı? (e/h)":           "Set current-context to this context? (y/n)",
+	"d               Seçili kaynağı sil (pod, deployment, service)":    "d               Delete the selected resource (pod, deployment, service)",
+	"desteklenmeyen kasa sürümü: %d":                                   "unsupported vault version: %d",
+	"desteklenmeyen kimlik doğrulama yöntemi: %s":                      "unsupported authentication method: %s",
+	"desteklenmeyen proxy şeması: %s (http, https veya socks5 olmalı)": "unsupported proxy scheme: %s (must be http, https or socks5)",
+	"desteklenmeyen resource tipi":                                     "unsupported resource type",
+	"desteklenmeyen resource tipi: %s":                                 "unsupported resource type: %s",
+	"desteklenmeyen çıktı biçimi: %s (desteklenenler: %s)":             "unsupported output format: %s (supported: %s)",
+	"dizin oluşturulamadı: %v":                                         "could not create directory: %v",
+	"dosya yazılamadı: %v":                                             "could not write file: %v",
+	"düzenlenmiş içerik okunamadı: %v":                                 "could not read the edited content: %v",
+	"e":                                                                "y",
+	"e               Seçili kaynağı $EDITOR ile düzenle (pod, deployment, service)": "e               Edit the selected resource with $EDITOR (pod, deployment, service)",
+	"editör çalıştırılamadı: %v":                                                    "could not run the editor: %v",
+	"evet":                                                                          "yes",
+	"geçersiz K8S_BURST değeri: %q":                                                 "invalid K8S_BURST value: %q",
+	"geçersiz K8S_INSECURE_SKIP_TLS_VERIFY değeri: %q":                              "invalid K8S_INSECURE_SKIP_TLS_VERIFY value: %q",
+	"geçersiz K8S_PROXY_URL değeri: %q":                                             "invalid K8S_PROXY_URL value: %q",
+	"geçersiz K8S_QPS değeri: %q":                                                   "invalid K8S_QPS value: %q",
+	"geçersiz K8S_SERVER_CERT_SHA256 değeri: SHA-256 parmak izi (64 hex hane) olmalı": "invalid K8S_SERVER_CERT_SHA256 value: must be a SHA-256 fingerprint (64 hex digits)",
+	"geçersiz K8S_TIMEOUT değeri: %q (örn. 30s, 2m)":                                  "invalid K8S_TIMEOUT value: %q (e.g. 30s, 2m)",
+	"geçersiz URL: %q":        "invalid URL: %q",
+	"geçersiz anahtar: %q":    "invalid key: %q",
+	"geçersiz context seçimi": "invalid context selection",
+	"geçersiz custom-columns tanımı: %q (BAŞLIK:.alan bekleniyordu)": "invalid custom-columns spec: %q (expected HEADER:.field)",
+	"geçersiz jsonpath şablonu: %v":                                  "invalid jsonpath template: %v",
+	"geçici dosya oluşturulamadı: %v":                                "could not create temporary file: %v",
+	"h":                                                              "n",
+	"https://host:6443 biçiminde olmalı":                             "must be in the form https://host:6443",
+	"in-cluster config oluşturulamadı: %v":                           "could not build in-cluster config: %v",
+	"izin var":                                                       "allowed",
+	"izin yok":                                                       "not allowed",
+	"izin yok, namespace kapsamlı modda çalışılacak":                 "not allowed, namespace-scoped mode will be used",
+	"kapanmayan çift tırnak":                                         "unterminated double quote",
+	"kasa dosyası ayrıştırılamadı: %v":                               "could not parse vault file: %v",
+	"kasa içeriği ayrıştırılamadı: %v":                               "could not parse vault contents: %v",
+	"kasa kilitli":                                                   "vault is locked",
+	"kasa parolası boş olamaz":                                       "vault passphrase cannot be empty",
+	"kasa parolası hatalı veya dosya bozuk":                          "wrong vault passphrase or corrupted file",
+	"komut satırı":                                                   "command line",
+	"kubeconfig dosyasında current-context tanımlı değil":            "no current-context is set in the kubeconfig file",
+	"kubeconfig dosyasında hiç context bulunamadı":                   "no contexts found in the kubeconfig file",
+	"kubeconfig oluşturulamadı: %v":                                  "could not build kubeconfig: %v",
+	"kubeconfig yazılamadı: %v":                                      "could not write kubeconfig: %v",
+	"kubeconfig yüklenemedi: %v":                                     "could not load kubeconfig: %v",
+	"kullanıcı config":                                               "user config",
+	"l               Pod loglarını göster (c: container değiştir, r: yenile)": "l               Show pod logs (c: switch container, r: reload)",
+	"manifest okunamadı: %v":                          "could not read manifest: %v",
+	"ortam değişkeni":                                 "environment variable",
+	"plugin token veya client sertifikası döndürmedi": "the plugin returned neither a token nor a client certificate",
+	"plugin çıktısı ExecCredential değil: %v\n--- plugin stderr ---\n%s":                  "plugin output is not an ExecCredential: %v\n--- plugin stderr ---\n%s",
+	"pod birden fazla container içeriyor, birini seçin: %s":                               "the pod has more than one container, choose one: %s",
+	"profil dosyası ayrıştırılamadı: %v":                                                  "could not parse profile file: %v",
+	"r               Listeyi yenile":                                                      "r               Refresh the list",
+	"sertifika pin'i bu transport türüyle uygulanamıyor: %T":                              "certificate pinning is not possible with this transport type: %T",
+	"son bağlantı dosyası ayrıştırılamadı: %v":                                            "could not parse last connection file: %v",
+	"sunucu TLS sertifikası sunmadı":                                                      "the server presented no TLS certificate",
+	"sunucu sertifikası K8S_SERVER_CERT_SHA256 pin'i ile eşleşmiyor, bağlantı reddedildi": "the server certificate does not match the K8S_SERVER_CERT_SHA256 pin, connection refused",
+	"sunucu zamanı alınamadı":                                                             "could not get the server time",
+	"sunucuya bağlanılamadı: %v":                                                          "could not connect to the server: %v",
+	"tam ekran arayüz için bir terminal gerekli":                                          "the full-screen interface requires a terminal",
+	"tamamlanmamış kaçış dizisi":                                                          "incomplete escape sequence",
+	"tanımlı (%s)":                                                                        "set (%s)",
+	"tanımlı değil":                                                                       "not set",
+	"tanımsız":                                                                            "unset",
+	"terminal raw moda alınamadı: %v":                                                     "could not put the terminal into raw mode: %v",
+	"token JWT formatında değil (%d parça)":                                               "the token is not a JWT (%d parts)",
+	"token oluşturulamadı: %v":                                                            "could not create token: %v",
+	"token yok":                                                                           "no token",
+	"tüm namespace'lerde":                                                                 "in all namespaces",
+	"tümü":                                                                                "all",
+	"yerel saat sunucudan %s farklı":                                                      "the local clock differs from the server by %s",
+	"yetki sorgulanamadı: ":                                                               "could not query permission: ",
+	"Çalışılan namespace: %s\n":                                                           "Working namespace: %s\n",
+	"Çıktı biçimi: ":                                                                      "Output format: ",
+	"Çıktı biçimi: %s\n":                                                                  "Output format: %s\n",
+	"Çıktı biçimleri: %s\n":                                                               "Output formats: %s\n",
+	"Çıkış kodları: %d başarılı, %d işlem hatası, %d kullanım hatası, %d bağlantı hatası\n": "Exit codes: %d success, %d operation error, %d usage error, %d connection error\n",
+	"Öncelik: komut satırı > ortam değişkeni > .env > kullanıcı config":                     "Precedence: command line > environment variable > .env > user config",
+	"Örnekler: delete deployments client-access | get pods/log default | list nodes":        "Examples: delete deployments client-access | get pods/log default | list nodes",
+	"önce bir Kubernetes cluster'ına bağlanmalısınız":                                       "you must connect to a Kubernetes cluster first",
+	"İSİM": "NAME",
+	"İpucu: Tüm namespace'leri listeleme yetkiniz yok. Kimlik Doğrulama menüsünden varsayılan namespace seçin veya K8S_NAMESPACE tanımlayın.": "Hint: you are not allowed to list all namespaces. Select a default namespace from the Authentication menu or set K8S_NAMESPACE.",
+	"İstekler artık '%s' olarak gönderilecek.\n":                      "Requests will now be sent as '%s'.\n",
+	"İstekler kendi kimliğinizle gönderilecek.":                       "Requests will be sent with your own identity.",
+	"İşlem iptal edildi.":                                             "Operation cancelled.",
+	"Şablon (örn. {.items[*].metadata.name}): ":                       "Template (e.g. {.items[*].metadata.name}): ",
+	"Şifreli kasa parolası: ":                                         "Encrypted vault passphrase: ",
+	"şema https değil: ":                                              "scheme is not https: ",
+	"şifreli kasa kilitli, gizli değer düz metin olarak kaydedilmedi": "encrypted vault is locked, secret value was not saved in plain text",
+	"şifreli kasa":                                                    "encrypted vault",
+	"←/→, Tab        Kaynak türünü değiştir":                          "←/→, Tab        Switch resource kind",
+	"↑/↓, j/k        Seçimi taşı":                                     "↑/↓, j/k        Move the selection",
+	"YAŞ":                                                             "AGE",
+	"SÜRÜM":                                                           "VERSION",
+	"HAZIR":                                                           "READY",
+	"get <tür> [isim] [-n namespace | -A] [-l selector] [-field-selector selector] [-match regex] [-o biçim]": "get <kind> [name] [-n namespace | -A] [-l selector] [-field-selector selector] [-match regex] [-o format]",
+	"tui [-n namespace] [-l selector] [-field-selector selector] [-match regex]":                              "tui [-n namespace] [-l selector] [-field-selector selector] [-match regex]",
+	"Kaynakları tablo olarak listeler":                      "Lists resources as a table",
+	"create -f <dosya.yaml | ->":                            "create -f <file.yaml | ->",
+	"YAML'daki Pod, Deployment ve Service'leri oluşturur":   "Creates the Pods, Deployments and Services in the YAML",
+	"delete <pod|deployment|service> <isim> [-n namespace]": "delete <pod|deployment|service> <name> [-n namespace]",
+	"Kaynağı siler":                                         "Deletes a resource",
+	"Pod loglarını yazdırır":                                "Prints pod logs",
+	"Tam ekran, klavye ile kullanılan arayüzü açar":         "Opens the full-screen, keyboard-driven interface",
+	"table, wide, json, yaml, csv, custom-columns=<BAŞLIK>:<.alan>,..., jsonpath=<şablon>": "table, wide, json, yaml, csv, custom-columns=<HEADER>:<.field>,..., jsonpath=<template>",
+	"kullanım: get <tür> [isim]":                             "usage: get <kind> [name]",
+	"bilinmeyen kaynak türü: %s (desteklenenler: %s)":        "unknown resource kind: %s (supported: %s)",
+	"kullanım: create -f <dosya.yaml | ->":                   "usage: create -f <file.yaml | ->",
+	"kullanım: delete <pod|deployment|service> <isim>":       "usage: delete <pod|deployment|service> <name>",
+	"bilinmeyen kaynak türü: %s":                             "unknown resource kind: %s",
+	"%s silme desteklenmiyor (pod, deployment veya service)": "deleting %s is not supported (pod, deployment or service)",
+	"kullanım: logs <pod>":                                   "usage: logs <pod>",
+	"kullanım: tui [-n namespace]":                           "usage: tui [-n namespace]",
+
+	// Liste filtreleri
+	"yok":                                          "none",
+	"isim~%s":                                      "name~%s",
+	"geçersiz label selector: %v":                  "invalid label selector: %v",
+	"geçersiz field selector: %v":                  "invalid field selector: %v",
+	"geçersiz isim filtresi: %v":                   "invalid name filter: %v",
+	"(Filtre: %s)\n":                               "(Filter: %s)\n",
+	"14. Filtreler (%s)\n":                         "14. Filters (%s)\n",
+	"15. Ana Menüye Dön":                           "15. Back to Main Menu",
+	"Seçiminiz (1-15): ":                           "Your choice (1-15): ",
+	"\n=== Liste Filtreleri ===":                   "\n=== List Filters ===",
+	"1. Namespace (%s)\n":                          "1. Namespace (%s)\n",
+	"2. Label Selector (%s)\n":                     "2. Label Selector (%s)\n",
+	"3. Field Selector (%s)\n":                     "3. Field Selector (%s)\n",
+	"4. İsim Filtresi - metin veya /regex/ (%s)\n": "4. Name Filter - text or /regex/ (%s)\n",
+	"5. Tüm Filtreleri Temizle":                    "5. Clear All Filters",
+	"Namespace (tümü için boş bırakın): ":          "Namespace (leave empty for all): ",
+	"Namespace: %s\n":                              "Namespace: %s\n",
+	"Label selector (örn. app=web,tier!=db; temizlemek için boş bırakın): ":     "Label selector (e.g. app=web,tier!=db; leave empty to clear): ",
+	"Field selector (örn. status.phase=Running; temizlemek için boş bırakın): ": "Field selector (e.g. status.phase=Running; leave empty to clear): ",
+	"İsim filtresi (örn. web veya /^api-/; temizlemek için boş bırakın): ":      "Name filter (e.g. web or /^api-/; leave empty to clear): ",
+	"Aktif filtreler: %s\n":                                       "Active filters: %s\n",
+	"Field selector (örn. status.phase=Running)":                  "Field selector (e.g. status.phase=Running)",
+	"İsim filtresi: büyük/küçük harf duyarsız metin veya /regex/": "Name filter: case-insensitive text or /regex/",
+	"Filtre: %s │ ":                                               "Filter: %s │ ",
+	"Uygulanacağı kaynak türü (boş bırakılırsa pods): ":           "Resource kind to apply it to (pods if left empty): ",
+	"Hata: bilinmeyen kaynak türü: %s (desteklenenler: %s)\n":     "Error: unknown resource kind: %s (supported: %s)\n",
+	"field selector için kaynak türü belirtilmeli":                "a resource kind is required for the field selector",
+
+	// Sayfalama
+	"\nSayfa %d":          "\nPage %d",
+	" | n: sonraki sayfa": " | n: next page",
+	" | p: önceki sayfa":  " | p: previous page",
+	"Sayfa değiştirmek için n/p, çıkmak için Enter: ":                  "Press n/p to change page, Enter to quit: ",
+	"Uyarı: Sayfalama bilgisinin süresi doldu, liste baştan alınıyor.": "Warning: The paging token expired, listing again from the start.",
+
+	// Debug modu
+	"[debug] %s: %d API çağrısı, %v\n": "[debug] %s: %d API calls, %v\n",
+	"pod sayımı (arka plan)":           "pod count (background)",
+	"sayfa %d":                         "page %d",
+
+	// Bağlantı tanılama başlıkları
+	"[ OK  ]":                "[ OK  ]",
+	"[UYARI]":                "[WARN ]",
+	"[HATA ]":                "[FAIL ]",
+	"[ATLA ]":                "[SKIP ]",
+	"API sunucu adresi":      "API server address",
+	"API discovery":          "API discovery",
+	"Client":                 "Client",
+	"HTTP client":            "HTTP client",
+	"RBAC (list namespaces)": "RBAC (list namespaces)",
+	"RBAC (list pods -n %s)": "RBAC (list pods -n %s)",
+	"Token":                  "Token",
+
+	// Service account kurulumu
+	"\nUyarı: ClusterRole '%s' bu araç tarafından oluşturulmamış; kuralları değişecek:\n":               "\nWarning: ClusterRole '%s' was not created by this tool; its rules will change:\n",
+	"\nUyarı: ClusterRoleBinding '%s' bu araç tarafından oluşturulmamış; rol ve hesapları değişecek:\n": "\nWarning: ClusterRoleBinding '%s' was not created by this tool; its role and subjects will change:\n",
+	"ClusterRole '%s' değiştirilmedi; başka bir isim seçin":                                             "ClusterRole '%s' was not changed; choose another name",
+	"ClusterRole '%s' güncellensin mi? [e/h]: ":                                                         "Update ClusterRole '%s'? [y/n]: ",
+	"ClusterRole '%s' okunamadı: %v":                                                                    "could not read ClusterRole '%s': %v",
+	"ClusterRoleBinding '%s' değiştirilmedi; başka bir isim seçin":                                      "ClusterRoleBinding '%s' was not changed; choose another name",
+	"ClusterRoleBinding '%s' güncellensin mi? [e/h]: ":                                                  "Update ClusterRoleBinding '%s'? [y/n]: ",
+
+	// Exec plugin argümanları ve zaman aşımı
+	"Uyarı: EXEC_ARGS ayrıştırılamadı (%v), boşluklardan bölünüyor\n":         "Warning: could not parse EXEC_ARGS (%v), splitting on whitespace\n",
+	"plugin %v içinde yanıt vermedi (zaman aşımı)\n--- plugin stderr ---\n%s": "plugin did not respond within %v (timed out)\n--- plugin stderr ---\n%s",
+	"geçersiz JSON argüman dizisi: %v":                                        "invalid JSON argument array: %v",
+	"kapanmayan tırnak":                                                       "unterminated quote",
+
+	// Pod sayımı
+	"Pod sayıları alınamadı: %v\n": "Could not get pod counts: %v\n",
+	"Pod sayıları: %s\n":           "Pod counts: %s\n",
+}
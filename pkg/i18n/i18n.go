@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Desteklenen diller
+const (
+	Turkish = "tr"
+	English = "en"
+)
+
+// Mesajlar gettext'teki gibi kaynak koddaki Türkçe metinle anahtarlanır:
+// Türkçe'de metin olduğu gibi gösterilir, diğer dillerde katalogda karşılığı
+// aranır, bulunamazsa yine Türkçe metin gösterilir.
+var catalogs = map[string]map[string]string{
+	English: english,
+}
+
+var language = Detect("")
+
+// Detect yapılandırmadaki dil değerini, o boşsa LC_ALL, LC_MESSAGES ve LANG
+// ortam değişkenlerini sırayla inceler. Tanınmayan diller için Türkçe döner.
+func Detect(configured string) string {
+	for _, value := range []string{configured, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG")} {
+		if value == "" {
+			continue
+		}
+		if lang := normalize(value); lang != "" {
+			return lang
+		}
+		// Tanınmayan ama tanımlı değer (ör. C, de_DE) sonraki kaynaklara bakılmasını engeller
+		return Turkish
+	}
+	return Turkish
+}
+
+// normalize "en_US.UTF-8" veya "tr-TR" gibi değerlerden dil kodunu çıkarır
+func normalize(value string) string {
+	lang := strings.ToLower(value)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[:i]
+	}
+	switch lang {
+	case Turkish, "turkish", "türkçe":
+		return Turkish
+	case English, "english":
+		return English
+	}
+	return ""
+}
+
+// SetLanguage arayüz dilini değiştirir
+func SetLanguage(lang string) {
+	language = lang
+}
+
+// Language etkin arayüz dilini döndürür
+func Language() string {
+	return language
+}
+
+// T Türkçe mesajın etkin dildeki karşılığını döndürür
+func T(msgid string) string {
+	if translated, ok := catalogs[language][msgid]; ok {
+		return translated
+	}
+	return msgid
+}
+
+// N metni çevirmeden döndürür; yalnızca metni çevrilecek olarak işaretler
+// (gettext_noop). Dil ayarlanmadan önce tanımlanan ve gösterilirken T ile
+// çevrilen metinler (örn. bayrak açıklamaları) için kullanılır.
+func N(msgid string) string {
+	return msgid
+}
+
+// Error gösterildiği anda etkin dile çevrilen sabit bir hata mesajıdır.
+// Paket düzeyindeki hata değişkenleri errors.New(T(...)) ile tanımlanırsa
+// dil ayarlanmadan çevrilmiş olur; onun yerine bu tip kullanılır.
+type Error string
+
+func (e Error) Error() string {
+	return T(string(e))
+}
+
+// Sprintf biçim metnini çevirip fmt.Sprintf gibi biçimlendirir
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Printf biçim metnini çevirip standart çıktıya yazar
+func Printf(format string, args ...interface{}) {
+	fmt.Printf(T(format), args...)
+}
+
+// Fprintf biçim metnini çevirip w'ye yazar
+func Fprintf(w io.Writer, format string, args ...interface{}) {
+	fmt.Fprintf(w, T(format), args...)
+}
+
+// Errorf biçim metnini çevirip hata oluşturur; %w desteklenir
+func Errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(T(format), args...)
+}
+
+// IsYes onay sorularına verilen cevabın evet olup olmadığını döndürür.
+// Dilden bağımsız olarak hem "e/evet" hem "y/yes" kabul edilir.
+func IsYes(answer string) bool {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "e", "evet", "y", "yes":
+		return true
+	}
+	return false
+}
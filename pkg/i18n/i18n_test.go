@@ -0,0 +1,168 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		lcAll      string
+		lang       string
+		want       string
+	}{
+		{name: "varsayılan", want: Turkish},
+		{name: "LANG ingilizce", lang: "en_US.UTF-8", want: English},
+		{name: "LANG türkçe", lang: "tr_TR.UTF-8", want: Turkish},
+		{name: "LC_ALL LANG'ı ezer", lcAll: "en_GB", lang: "tr_TR.UTF-8", want: English},
+		{name: "config ortamı ezer", configured: "tr", lcAll: "en_US.UTF-8", want: Turkish},
+		{name: "config ingilizce", configured: "English", lang: "tr_TR", want: English},
+		{name: "tanınmayan dil", lang: "de_DE.UTF-8", want: Turkish},
+		{name: "C locale", lcAll: "C", lang: "en_US", want: Turkish},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LC_ALL", tt.lcAll)
+			t.Setenv("LC_MESSAGES", "")
+			t.Setenv("LANG", tt.lang)
+			if got := Detect(tt.configured); got != tt.want {
+				t.Errorf("Detect(%q) = %q, beklenen %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	defer SetLanguage(Language())
+
+	SetLanguage(Turkish)
+	if got := T("İSİM"); got != "İSİM" {
+		t.Errorf("T(İSİM) = %q, Türkçe'de metin değişmemeli", got)
+	}
+	err := Error("kasa kilitli") // Paket düzeyindeki hatalar dil ayarlanmadan önce oluşturulur
+
+	SetLanguage(English)
+	if got := T("İSİM"); got != "NAME" {
+		t.Errorf("T(İSİM) = %q, beklenen NAME", got)
+	}
+	if got := err.Error(); got != "vault is locked" {
+		t.Errorf("Error gösterildiği anda çevrilmeli, %q döndü", got)
+	}
+	if got := Sprintf("Hata: %v\n", "x"); got != "Error: x\n" {
+		t.Errorf("Sprintf = %q", got)
+	}
+	if got := T("katalogda olmayan metin"); got != "katalogda olmayan metin" {
+		t.Errorf("çevirisi olmayan metin olduğu gibi dönmeli, %q döndü", got)
+	}
+}
+
+func TestIsYes(t *testing.T) {
+	for _, answer := range []string{"e", "E", "evet", "y", "Yes", " e\n"} {
+		if !IsYes(answer) {
+			t.Errorf("IsYes(%q) = false", answer)
+		}
+	}
+	for _, answer := range []string{"", "h", "hayır", "n", "no", "x"} {
+		if IsYes(answer) {
+			t.Errorf("IsYes(%q) = true", answer)
+		}
+	}
+}
+
+var verbPattern = regexp.MustCompile(`%[-+# 0-9.*\[\]]*[a-zA-Z%]`)
+
+// TestEnglishCatalogComplete kaynak koddaki tüm çevrilebilir metinlerin
+// İngilizce katalogda karşılığı olduğunu ve biçim fiillerinin korunduğunu doğrular
+func TestEnglishCatalogComplete(t *testing.T) {
+	msgids := sourceMessages(t, filepath.Join("..", ".."))
+	if len(msgids) == 0 {
+		t.Fatal("kaynak kodda çevrilebilir metin bulunamadı")
+	}
+
+	for _, msgid := range msgids {
+		translated, ok := english[msgid]
+		if !ok {
+			t.Errorf("İngilizce çeviri eksik: %q", msgid)
+			continue
+		}
+		if got, want := verbPattern.FindAllString(translated, -1), verbPattern.FindAllString(msgid, -1); !slices.Equal(got, want) {
+			t.Errorf("%q çevirisindeki biçim fiilleri %v, beklenen %v", msgid, got, want)
+		}
+	}
+}
+
+// sourceMessages test dışı Go dosyalarında i18n fonksiyonlarına verilen sabit
+// metinleri toplar
+func sourceMessages(t *testing.T, root string) []string {
+	t.Helper()
+	seen := map[string]bool{}
+	add := func(lit ast.Expr) {
+		if basic, ok := lit.(*ast.BasicLit); ok && basic.Kind == token.STRING {
+			if value, err := strconv.Unquote(basic.Value); err == nil {
+				seen[value] = true
+			}
+		}
+	}
+
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && strings.HasPrefix(d.Name(), ".") && path != root {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		inPackage := file.Name.Name == "i18n"
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr:
+				name := ""
+				switch fun := n.Fun.(type) {
+				case *ast.SelectorExpr:
+					if pkg, ok := fun.X.(*ast.Ident); ok && pkg.Name == "i18n" {
+						name = fun.Sel.Name
+					}
+				case *ast.Ident:
+					if inPackage {
+						name = fun.Name
+					}
+				}
+				switch {
+				case name == "Fprintf" && len(n.Args) > 1:
+					add(n.Args[1])
+				case (name == "T" || name == "N" || name == "Error" || name == "Printf" || name == "Sprintf" || name == "Errorf") && len(n.Args) > 0:
+					add(n.Args[0])
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgids := make([]string, 0, len(seen))
+	for msgid := range seen {
+		msgids = append(msgids, msgid)
+	}
+	slices.Sort(msgids)
+	return msgids
+}
@@ -0,0 +1,316 @@
+package info
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"tamerGoClient/pkg/output"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Bu dosyadaki sütun tanımları tablo, wide ve csv çıktılarında kullanılır.
+// NAMESPACE sütunu dahil değildir; tüm namespace'ler listelenirken
+// output.NamespaceColumn başa eklenir.
+
+var NamespaceColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "DURUM", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.Namespace).Status.Phase)
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "LABELS", Wide: true, Value: labels},
+}
+
+var NodeColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "DURUM", Value: func(obj runtime.Object) string {
+		return nodeStatus(obj.(*corev1.Node))
+	}},
+	{Header: "SÜRÜM", Value: func(obj runtime.Object) string {
+		return obj.(*corev1.Node).Status.NodeInfo.KubeletVersion
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "CPU", Wide: true, Value: func(obj runtime.Object) string {
+		return obj.(*corev1.Node).Status.Allocatable.Cpu().String()
+	}},
+	{Header: "MEMORY", Wide: true, Value: func(obj runtime.Object) string {
+		return obj.(*corev1.Node).Status.Allocatable.Memory().String()
+	}},
+	{Header: "INTERNAL-IP", Wide: true, Value: func(obj runtime.Object) string {
+		for _, address := range obj.(*corev1.Node).Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				return address.Address
+			}
+		}
+		return output.None
+	}},
+	{Header: "OS", Wide: true, Value: func(obj runtime.Object) string {
+		return obj.(*corev1.Node).Status.NodeInfo.OSImage
+	}},
+	{Header: "CONTAINER-RUNTIME", Wide: true, Value: func(obj runtime.Object) string {
+		return obj.(*corev1.Node).Status.NodeInfo.ContainerRuntimeVersion
+	}},
+}
+
+var PodColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "HAZIR", Value: func(obj runtime.Object) string {
+		pod := obj.(*corev1.Pod)
+		ready := 0
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+		}
+		return fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers))
+	}},
+	{Header: "DURUM", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.Pod).Status.Phase)
+	}},
+	{Header: "RESTART", Value: func(obj runtime.Object) string {
+		restarts := int32(0)
+		for _, status := range obj.(*corev1.Pod).Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+		return fmt.Sprint(restarts)
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "IP", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(obj.(*corev1.Pod).Status.PodIP)
+	}},
+	{Header: "NODE", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(obj.(*corev1.Pod).Spec.NodeName)
+	}},
+}
+
+var DeploymentColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "READY", Value: func(obj runtime.Object) string {
+		deploy := obj.(*appsv1.Deployment)
+		return fmt.Sprintf("%d/%d", deploy.Status.ReadyReplicas, deploy.Status.Replicas)
+	}},
+	{Header: "UP-TO-DATE", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*appsv1.Deployment).Status.UpdatedReplicas)
+	}},
+	{Header: "AVAILABLE", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*appsv1.Deployment).Status.AvailableReplicas)
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "IMAGES", Wide: true, Value: func(obj runtime.Object) string {
+		return images(obj.(*appsv1.Deployment).Spec.Template.Spec.Containers)
+	}},
+	{Header: "SELECTOR", Wide: true, Value: func(obj runtime.Object) string {
+		return metav1.FormatLabelSelector(obj.(*appsv1.Deployment).Spec.Selector)
+	}},
+}
+
+var ServiceColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "TYPE", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.Service).Spec.Type)
+	}},
+	{Header: "CLUSTER-IP", Value: func(obj runtime.Object) string {
+		return orNone(obj.(*corev1.Service).Spec.ClusterIP)
+	}},
+	{Header: "PORTS", Value: func(obj runtime.Object) string {
+		svc := obj.(*corev1.Service)
+		ports := make([]string, 0, len(svc.Spec.Ports))
+		for _, port := range svc.Spec.Ports {
+			ports = append(ports, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
+		}
+		return orNone(strings.Join(ports, ","))
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "SELECTOR", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(joinMap(obj.(*corev1.Service).Spec.Selector))
+	}},
+}
+
+var ConfigMapColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "DATA", Value: func(obj runtime.Object) string {
+		cm := obj.(*corev1.ConfigMap)
+		return fmt.Sprint(len(cm.Data) + len(cm.BinaryData))
+	}},
+	{Header: "YAŞ", Value: age},
+}
+
+// SecretColumns değerleri değil yalnızca anahtar sayısını gösterir
+var SecretColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "TYPE", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.Secret).Type)
+	}},
+	{Header: "DATA", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(len(obj.(*corev1.Secret).Data))
+	}},
+	{Header: "YAŞ", Value: age},
+}
+
+var PersistentVolumeColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "CAPACITY", Value: func(obj runtime.Object) string {
+		return obj.(*corev1.PersistentVolume).Spec.Capacity.Storage().String()
+	}},
+	{Header: "ACCESS MODES", Value: func(obj runtime.Object) string {
+		return orNone(accessModesToString(obj.(*corev1.PersistentVolume).Spec.AccessModes))
+	}},
+	{Header: "STATUS", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.PersistentVolume).Status.Phase)
+	}},
+	{Header: "CLAIM", Value: func(obj runtime.Object) string {
+		pv := obj.(*corev1.PersistentVolume)
+		if pv.Spec.ClaimRef == nil {
+			return output.None
+		}
+		return pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "STORAGECLASS", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(obj.(*corev1.PersistentVolume).Spec.StorageClassName)
+	}},
+	{Header: "RECLAIM POLICY", Wide: true, Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.PersistentVolume).Spec.PersistentVolumeReclaimPolicy)
+	}},
+}
+
+var PersistentVolumeClaimColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "STATUS", Value: func(obj runtime.Object) string {
+		return string(obj.(*corev1.PersistentVolumeClaim).Status.Phase)
+	}},
+	{Header: "VOLUME", Value: func(obj runtime.Object) string {
+		return orNone(obj.(*corev1.PersistentVolumeClaim).Spec.VolumeName)
+	}},
+	{Header: "CAPACITY", Value: func(obj runtime.Object) string {
+		return obj.(*corev1.PersistentVolumeClaim).Status.Capacity.Storage().String()
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "STORAGECLASS", Wide: true, Value: func(obj runtime.Object) string {
+		if class := obj.(*corev1.PersistentVolumeClaim).Spec.StorageClassName; class != nil {
+			return *class
+		}
+		return output.None
+	}},
+	{Header: "ACCESS MODES", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(accessModesToString(obj.(*corev1.PersistentVolumeClaim).Spec.AccessModes))
+	}},
+}
+
+var StatefulSetColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "READY", Value: func(obj runtime.Object) string {
+		sts := obj.(*appsv1.StatefulSet)
+		return fmt.Sprintf("%d/%d", sts.Status.ReadyReplicas, sts.Status.Replicas)
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "SERVICE NAME", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(obj.(*appsv1.StatefulSet).Spec.ServiceName)
+	}},
+	{Header: "IMAGES", Wide: true, Value: func(obj runtime.Object) string {
+		return images(obj.(*appsv1.StatefulSet).Spec.Template.Spec.Containers)
+	}},
+}
+
+var DaemonSetColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "DESIRED", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*appsv1.DaemonSet).Status.DesiredNumberScheduled)
+	}},
+	{Header: "CURRENT", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*appsv1.DaemonSet).Status.CurrentNumberScheduled)
+	}},
+	{Header: "READY", Value: func(obj runtime.Object) string {
+		return fmt.Sprint(obj.(*appsv1.DaemonSet).Status.NumberReady)
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "NODE SELECTOR", Wide: true, Value: func(obj runtime.Object) string {
+		return orNone(joinMap(obj.(*appsv1.DaemonSet).Spec.Template.Spec.NodeSelector))
+	}},
+}
+
+var IngressColumns = []output.Column{
+	{Header: "İSİM", Value: name},
+	{Header: "CLASS", Value: func(obj runtime.Object) string {
+		if class := obj.(*networkingv1.Ingress).Spec.IngressClassName; class != nil {
+			return *class
+		}
+		return output.None
+	}},
+	{Header: "HOSTS", Value: func(obj runtime.Object) string {
+		hosts := []string{}
+		for _, rule := range obj.(*networkingv1.Ingress).Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+		}
+		return orNone(strings.Join(hosts, ","))
+	}},
+	{Header: "YAŞ", Value: age},
+	{Header: "TLS", Wide: true, Value: func(obj runtime.Object) string {
+		secrets := []string{}
+		for _, tls := range obj.(*networkingv1.Ingress).Spec.TLS {
+			secrets = append(secrets, tls.SecretName)
+		}
+		return orNone(strings.Join(secrets, ","))
+	}},
+}
+
+func name(obj runtime.Object) string {
+	return obj.(metav1.Object).GetName()
+}
+
+// age oluşturulma zamanını kubectl'deki gibi kısa süre olarak biçimlendirir
+func age(obj runtime.Object) string {
+	created := obj.(metav1.Object).GetCreationTimestamp()
+	if created.IsZero() {
+		return output.Unknown
+	}
+	return duration.HumanDuration(time.Since(created.Time))
+}
+
+func labels(obj runtime.Object) string {
+	return orNone(joinMap(obj.(metav1.Object).GetLabels()))
+}
+
+func nodeStatus(node *corev1.Node) string {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+			return "Ready"
+		}
+	}
+	return "NotReady"
+}
+
+func images(containers []corev1.Container) string {
+	result := make([]string, len(containers))
+	for i, container := range containers {
+		result[i] = container.Image
+	}
+	return orNone(strings.Join(result, ","))
+}
+
+// joinMap map'i anahtara göre sıralı "k=v,k2=v2" biçiminde birleştirir
+func joinMap(values map[string]string) string {
+	pairs := make([]string, 0, len(values))
+	for key, value := range values {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return output.None
+	}
+	return value
+}
@@ -0,0 +1,187 @@
+package info
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// podCountWorkers cluster genelinde yetki olmadığında aynı anda sayılan
+// namespace sayısı
+const podCountWorkers = 8
+
+// PodCounts cluster'daki tüm podları sayfa sayfa bir kez listeleyip key'in
+// döndürdüğü değere göre sayar. Namespace ve node listeleri her satır için
+// ayrı istek atmak yerine bunu kullanır; podların kendisi bellekte tutulmaz.
+// Oturumun namespace'i ve filtreleri sayıma uygulanmaz. Cluster genelinde pod
+// listeleme yetkisi yoksa namespace'ler sınırlı sayıda eş zamanlı istekle tek
+// tek sayılır.
+func PodCounts(s *session.Session, key func(pod *corev1.Pod) string) (map[string]int, error) {
+	counts, err := countPods(s, metav1.NamespaceAll, key)
+	if !apierrors.IsForbidden(err) {
+		return counts, err
+	}
+	namespaces, nsErr := Collect(s, allNamespaces, metav1.ListOptions{})
+	if nsErr != nil {
+		return nil, err
+	}
+	return countPodsPerNamespace(s, namespaces.Items, key)
+}
+
+// countPods namespace'teki podları sayfa sayfa listeleyip sayar
+func countPods(s *session.Session, namespace string, key func(pod *corev1.Pod) string) (map[string]int, error) {
+	fetch := func(s *session.Session, opts metav1.ListOptions) (*corev1.PodList, error) {
+		ctx, cancel := s.Context()
+		defer cancel()
+		return s.Client.CoreV1().Pods(namespace).List(session.WithBackground(ctx), opts)
+	}
+	pager := &Pager[*corev1.PodList]{s: s, fetch: fetch, opts: metav1.ListOptions{Limit: collectChunkSize}}
+	var counts map[string]int
+	for list, err := pager.First(); ; list, err = pager.Next() {
+		if err != nil {
+			return nil, err
+		}
+		if pager.Number() == 1 {
+			// İlk sayfa ya da continue token'ının süresi dolduğu için liste
+			// baştan alındı; önceki sayfalar tekrar sayılmasın
+			counts = map[string]int{}
+		}
+		for i := range list.Items {
+			counts[key(&list.Items[i])]++
+		}
+		if !pager.HasNext() {
+			return counts, nil
+		}
+	}
+}
+
+// countPodsPerNamespace namespace'leri podCountWorkers kadar eş zamanlı
+// istekle sayıp sonuçları birleştirir. Bir namespace sayılamazsa kalanlar
+// atlanır ve ilk hata döner.
+func countPodsPerNamespace(s *session.Session, namespaces []corev1.Namespace, key func(pod *corev1.Pod) string) (map[string]int, error) {
+	jobs := make(chan string)
+	counts := map[string]int{}
+	var firstErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for range min(podCountWorkers, len(namespaces)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for namespace := range jobs {
+				mu.Lock()
+				failed := firstErr != nil
+				mu.Unlock()
+				if failed {
+					continue
+				}
+
+				nsCounts, err := countPods(s, namespace, key)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				for k, n := range nsCounts {
+					counts[k] += n
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range namespaces {
+		jobs <- namespaces[i].Name
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return counts, nil
+}
+
+func allNamespaces(s *session.Session, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	return s.Client.CoreV1().Namespaces().List(session.WithBackground(ctx), opts)
+}
+
+// asyncPodCounts arka planda yürüyen bir pod sayımı. Sayfalar sayımı
+// beklemeden yazılır; sayım bitmemişse hücrede yer tutucu gösterilir.
+type asyncPodCounts struct {
+	done   chan struct{}
+	counts map[string]int
+	err    error
+}
+
+// podCountsAsync PodCounts'u arka planda başlatır; böylece sayım, gösterilecek
+// listenin alınmasıyla eş zamanlı yürür. Debug modunda sayımın çağrıları
+// sayfa ölçümlerine katılmaz, ayrı bir satırda raporlanır.
+func podCountsAsync(s *session.Session, key func(pod *corev1.Pod) string) *asyncPodCounts {
+	c := &asyncPodCounts{done: make(chan struct{})}
+	go func() {
+		defer close(c.done)
+		measure := s.MeasureBackground()
+		c.counts, c.err = PodCounts(s, key)
+		measure(i18n.T("pod sayımı (arka plan)"))
+	}()
+	return c
+}
+
+// ready sayımın bitip bitmediğini beklemeden döndürür
+func (c *asyncPodCounts) ready() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait sayımın bitmesini bekler
+func (c *asyncPodCounts) wait() (map[string]int, error) {
+	<-c.done
+	return c.counts, c.err
+}
+
+// cell key'in pod sayısını tablo hücresi olarak döndürür: sayım bitmediyse
+// "...", alınamadıysa N/A
+func (c *asyncPodCounts) cell(key string) string {
+	if !c.ready() {
+		return "..."
+	}
+	if c.err != nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d", c.counts[key])
+}
+
+// printLate sayfa sayım bitmeden yazıldıysa sayımı bekleyip sayfadaki
+// satırların pod sayılarını sayfanın altına yazar
+func (c *asyncPodCounts) printLate(keys []string) {
+	counts, err := c.wait()
+	if err != nil {
+		i18n.Printf("Pod sayıları alınamadı: %v\n", err)
+		return
+	}
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s=%d", key, counts[key])
+	}
+	i18n.Printf("Pod sayıları: %s\n", strings.Join(parts, ", "))
+}
+
+func podNamespace(pod *corev1.Pod) string {
+	return pod.Namespace
+}
+
+func podNode(pod *corev1.Pod) string {
+	return pod.Spec.NodeName
+}
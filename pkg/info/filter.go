@@ -0,0 +1,96 @@
+package info
+
+import (
+	"fmt"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// selectFilters oturum boyunca tüm listelere uygulanacak namespace, selector
+// ve isim filtrelerini düzenletir
+func selectFilters(s *session.Session) {
+	for {
+		fmt.Println(i18n.T("\n=== Liste Filtreleri ==="))
+		i18n.Printf("1. Namespace (%s)\n", s.NamespaceLabel())
+		i18n.Printf("2. Label Selector (%s)\n", filterValue(s.Settings.Filter.LabelSelector, ""))
+		i18n.Printf("3. Field Selector (%s)\n", filterValue(s.Settings.Filter.FieldSelector, s.Settings.Filter.FieldSelectorKind))
+		i18n.Printf("4. İsim Filtresi - metin veya /regex/ (%s)\n", filterValue(s.Settings.Filter.Name, ""))
+		fmt.Println(i18n.T("5. Tüm Filtreleri Temizle"))
+		fmt.Println(i18n.T("6. Önceki Menüye Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-6): "))
+
+		var choice int
+		fmt.Scanf("%d", &choice)
+
+		filter := s.Settings.Filter
+		switch choice {
+		case 1:
+			fmt.Print(i18n.T("Namespace (tümü için boş bırakın): "))
+			namespace := readLine()
+			if namespace != "" {
+				if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+					i18n.Printf("Hata: Geçersiz namespace adı: %s\n", strings.Join(errs, "; "))
+					continue
+				}
+			}
+			s.Namespace = namespace
+			i18n.Printf("Namespace: %s\n", s.NamespaceLabel())
+			continue
+		case 2:
+			fmt.Print(i18n.T("Label selector (örn. app=web,tier!=db; temizlemek için boş bırakın): "))
+			filter.LabelSelector = readLine()
+		case 3:
+			fmt.Print(i18n.T("Field selector (örn. status.phase=Running; temizlemek için boş bırakın): "))
+			filter.FieldSelector, filter.FieldSelectorKind = readLine(), ""
+			if filter.FieldSelector == "" {
+				break
+			}
+			// Desteklenen alanlar türe göre değiştiğinden selector yalnızca bir türe uygulanır
+			filter.FieldSelectorKind = "pods"
+			if err := filter.Validate(); err != nil {
+				i18n.Printf("Hata: %v\n", err)
+				continue
+			}
+			fmt.Print(i18n.T("Uygulanacağı kaynak türü (boş bırakılırsa pods): "))
+			if name := readLine(); name != "" {
+				kind, found := FindKind(name)
+				if !found {
+					i18n.Printf("Hata: bilinmeyen kaynak türü: %s (desteklenenler: %s)\n", name, KindNames())
+					continue
+				}
+				filter.FieldSelectorKind = kind.Name
+			}
+		case 4:
+			fmt.Print(i18n.T("İsim filtresi (örn. web veya /^api-/; temizlemek için boş bırakın): "))
+			filter.Name = readLine()
+		case 5:
+			filter = session.Filter{}
+		case 6:
+			return
+		default:
+			fmt.Println(i18n.T("Geçersiz seçim!"))
+			continue
+		}
+
+		if err := filter.Validate(); err != nil {
+			i18n.Printf("Hata: %v\n", err)
+			continue
+		}
+		s.Settings.Filter = filter
+		i18n.Printf("Aktif filtreler: %s\n", filter)
+	}
+}
+
+func filterValue(value, kind string) string {
+	if value == "" {
+		return i18n.T("yok")
+	}
+	if kind != "" {
+		return value + " (" + kind + ")"
+	}
+	return value
+}
@@ -0,0 +1,102 @@
+package info
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/output"
+	"tamerGoClient/pkg/session"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// printFormatted oturumun çıktı biçimi tablo değilse listeyi o biçimde yazar
+// ve true döner; bu durumda çağıran menü tablosunu ve seçim adımını atlar.
+func printFormatted(s *session.Session, list runtime.Object, columns []output.Column, namespaced bool) bool {
+	if output.IsTable(s.Settings.Output) {
+		return false
+	}
+	if namespaced {
+		columns = append([]output.Column{output.NamespaceColumn}, columns...)
+	}
+	fmt.Println()
+	if err := output.Print(os.Stdout, s.Settings.Output, list, columns); err != nil {
+		i18n.Printf("Hata: Çıktı oluşturulamadı: %v\n", err)
+	}
+	return true
+}
+
+func formatLabel(format string) string {
+	if output.IsTable(format) {
+		return output.Table
+	}
+	return format
+}
+
+// selectOutputFormat listelerde kullanılacak çıktı biçimini oturum için değiştirir
+func selectOutputFormat(s *session.Session) {
+	i18n.Printf("\nMevcut çıktı biçimi: %s\n", formatLabel(s.Settings.Output))
+	fmt.Println(i18n.T("1. Tablo (varsayılan)"))
+	fmt.Println(i18n.T("2. Wide (ek sütunlarla tablo)"))
+	fmt.Println("3. JSON")
+	fmt.Println("4. YAML")
+	fmt.Println("5. CSV")
+	fmt.Println("6. Custom Columns")
+	fmt.Println("7. JSONPath")
+	fmt.Println(i18n.T("8. Önceki Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-8): "))
+
+	var choice int
+	fmt.Scanf("%d", &choice)
+
+	var format string
+	switch choice {
+	case 1:
+		format = output.Table
+	case 2:
+		format = output.Wide
+	case 3:
+		format = output.JSON
+	case 4:
+		format = output.YAML
+	case 5:
+		format = output.CSV
+	case 6:
+		fmt.Print(i18n.T("Sütunlar (örn. NAME:.metadata.name,NODE:.spec.nodeName): "))
+		format = output.CustomColumns + "=" + readLine()
+	case 7:
+		fmt.Print(i18n.T("Şablon (örn. {.items[*].metadata.name}): "))
+		format = output.JSONPath + "=" + readLine()
+	case 8:
+		return
+	default:
+		fmt.Println(i18n.T("Geçersiz seçim!"))
+		return
+	}
+
+	if _, err := output.NewPrinter(format); err != nil {
+		i18n.Printf("Hata: %v\n", err)
+		return
+	}
+	s.Settings.Output = format
+	i18n.Printf("Çıktı biçimi: %s\n", formatLabel(format))
+}
+
+// readLine boşluk içerebilen bir satır okur. Stdin bayt bayt okunur; tamponlu
+// bir okuyucu sonraki fmt.Scanf çağrılarına ait girdiyi de tüketirdi.
+func readLine() string {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(b)
+		if n > 0 && b[0] != '\n' {
+			line = append(line, b[0])
+		}
+		if err != nil || (n > 0 && b[0] == '\n') {
+			break
+		}
+	}
+	return strings.TrimSpace(string(line))
+}
@@ -4,37 +4,48 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
-	"tamerGoClient/pkg/auth"
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/output"
+	"tamerGoClient/pkg/session"
 	"tamerGoClient/pkg/utils"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func showInfoMenu() int {
-	fmt.Println("\n=== Cluster Bilgileri Menüsü ===")
-	if auth.GetActiveConnection() != "" {
-		fmt.Printf("(Aktif Bağlantı: %s)\n", auth.GetActiveConnection())
-	}
-	fmt.Println("1. Namespace Listesi")
-	fmt.Println("2. Node Bilgileri")
-	fmt.Println("3. Pod Listesi")
-	fmt.Println("4. Service Listesi")
-	fmt.Println("5. Deployment Listesi")
-	fmt.Println("6. ConfigMap Listesi")
-	fmt.Println("7. Secret Listesi")
-	fmt.Println("8. PersistentVolume Listesi")
-	fmt.Println("9. PersistentVolumeClaim Listesi")
-	fmt.Println("10. StatefulSet Listesi")
-	fmt.Println("11. DaemonSet Listesi")
-	fmt.Println("12. Ingress Listesi")
-	fmt.Println("13. Ana Menüye Dön")
-	fmt.Print("Seçiminiz (1-13): ")
+func showInfoMenu(s *session.Session) int {
+	fmt.Println(i18n.T("\n=== Cluster Bilgileri Menüsü ==="))
+	if s.Name != "" {
+		i18n.Printf("(Aktif Bağlantı: %s)\n", s.Name)
+	}
+	fmt.Printf("(Namespace: %s)\n", s.NamespaceLabel())
+	if !s.Settings.Filter.IsEmpty() {
+		i18n.Printf("(Filtre: %s)\n", s.Settings.Filter)
+	}
+	fmt.Println(i18n.T("1. Namespace Listesi"))
+	fmt.Println(i18n.T("2. Node Bilgileri"))
+	fmt.Println(i18n.T("3. Pod Listesi"))
+	fmt.Println(i18n.T("4. Service Listesi"))
+	fmt.Println(i18n.T("5. Deployment Listesi"))
+	fmt.Println(i18n.T("6. ConfigMap Listesi"))
+	fmt.Println(i18n.T("7. Secret Listesi"))
+	fmt.Println(i18n.T("8. PersistentVolume Listesi"))
+	fmt.Println(i18n.T("9. PersistentVolumeClaim Listesi"))
+	fmt.Println(i18n.T("10. StatefulSet Listesi"))
+	fmt.Println(i18n.T("11. DaemonSet Listesi"))
+	fmt.Println(i18n.T("12. Ingress Listesi"))
+	i18n.Printf("13. Çıktı Biçimi Seç (%s)\n", formatLabel(s.Settings.Output))
+	i18n.Printf("14. Filtreler (%s)\n", s.Settings.Filter)
+	fmt.Println(i18n.T("15. Ana Menüye Dön"))
+	fmt.Print(i18n.T("Seçiminiz (1-15): "))
 
 	var choice int
 	fmt.Scanf("%d", &choice)
@@ -42,54 +53,60 @@ func showInfoMenu() int {
 }
 
 func waitForContinue() bool {
-	fmt.Print("\nBaşka sorgu yapmak için 'c' tuşuna, ana menüye dönmek için 'q' tuşuna basın: ")
+	fmt.Print(i18n.T("\nBaşka sorgu yapmak için 'c' tuşuna, ana menüye dönmek için 'q' tuşuna basın: "))
 	var input string
 	fmt.Scanf("%s", &input)
 	for input != "c" && input != "q" {
-		fmt.Print("Geçersiz tuş! Başka sorgu yapmak için 'c', ana menüye dönmek için 'q' tuşuna basın: ")
+		fmt.Print(i18n.T("Geçersiz tuş! Başka sorgu yapmak için 'c', ana menüye dönmek için 'q' tuşuna basın: "))
 		fmt.Scanf("%s", &input)
 	}
 	return input == "c" // true ise devam et, false ise ana menüye dön
 }
 
-func HandleInfoMenu() {
-	if auth.KubeClient == nil {
-		fmt.Println("\nUyarı: Önce bir Kubernetes cluster'ına bağlanmalısınız!")
+func HandleInfoMenu(s *session.Session) {
+	if s == nil {
+		fmt.Println(i18n.T("\nUyarı: Önce bir Kubernetes cluster'ına bağlanmalısınız!"))
 		return
 	}
 
 	for {
-		choice := showInfoMenu()
+		choice := showInfoMenu(s)
 
 		switch choice {
 		case 1:
-			listNamespaces()
+			listNamespaces(s)
 		case 2:
-			listNodes()
+			listNodes(s)
 		case 3:
-			ListPods()
+			ListPods(s)
 		case 4:
-			ListServicesWithDetails()
+			ListServicesWithDetails(s)
 		case 5:
-			ListDeploymentsWithDetails()
+			ListDeploymentsWithDetails(s)
 		case 6:
-			listConfigMaps()
+			listConfigMaps(s)
 		case 7:
-			listSecrets()
+			listSecrets(s)
 		case 8:
-			listPersistentVolumes()
+			listPersistentVolumes(s)
 		case 9:
-			listPersistentVolumeClaims()
+			listPersistentVolumeClaims(s)
 		case 10:
-			listStatefulSets()
+			listStatefulSets(s)
 		case 11:
-			listDaemonSets()
+			listDaemonSets(s)
 		case 12:
-			listIngresses()
+			listIngresses(s)
 		case 13:
+			selectOutputFormat(s)
+			continue
+		case 14:
+			selectFilters(s)
+			continue
+		case 15:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 			continue
 		}
 
@@ -100,153 +117,170 @@ func HandleInfoMenu() {
 	}
 }
 
-func listNamespaces() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	namespaces, err := auth.KubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Namespace listesi alınamadı: %v\n", err)
-		return
+func listNamespaces(s *session.Session) {
+	// Pod sayıları yalnızca tabloda gösterilir; sayım liste alınırken arka
+	// planda yapılır ve sayfa sayımı beklemeden yazılır
+	var podCounts *asyncPodCounts
+	if output.IsTable(s.Settings.Output) {
+		podCounts = podCountsAsync(s, podNamespace)
 	}
+	err := browsePages(NewPager(s, Namespaces, metav1.ListOptions{}), func(namespaces *corev1.NamespaceList) {
+		if printFormatted(s, namespaces, NamespaceColumns, false) {
+			return
+		}
 
-	fmt.Println("\nNamespace Listesi:")
-	fmt.Printf("%-30s %-15s %-15s %-20s %-20s\n",
-		"İSİM", "DURUM", "POD SAYISI", "OLUŞTURULMA", "LABELS")
+		pending := !podCounts.ready()
+		fmt.Println(i18n.T("\nNamespace Listesi:"))
+		fmt.Printf("%-30s %-15s %-15s %-20s %-20s\n",
+			i18n.T("İSİM"), i18n.T("DURUM"), i18n.T("POD SAYISI"), i18n.T("OLUŞTURULMA"), "LABELS")
 
-	for _, ns := range namespaces.Items {
-		// Pod sayısını al
-		pods, err := auth.KubeClient.CoreV1().Pods(ns.Name).List(ctx, metav1.ListOptions{})
-		podCount := "N/A"
-		if err == nil {
-			podCount = fmt.Sprintf("%d", len(pods.Items))
-		}
+		names := make([]string, 0, len(namespaces.Items))
+		for _, ns := range namespaces.Items {
+			names = append(names, ns.Name)
+
+			// Label'ları string'e çevir
+			labels := []string{}
+			for key, value := range ns.Labels {
+				labels = append(labels, fmt.Sprintf("%s=%s", key, value))
+			}
+			labelStr := "N/A"
+			if len(labels) > 0 {
+				labelStr = strings.Join(labels, ",")
+			}
 
-		// Label'ları string'e çevir
-		labels := []string{}
-		for key, value := range ns.Labels {
-			labels = append(labels, fmt.Sprintf("%s=%s", key, value))
+			fmt.Printf("%-30s %-15s %-15s %-20s %-20s\n",
+				ns.Name,
+				string(ns.Status.Phase),
+				podCounts.cell(ns.Name),
+				ns.CreationTimestamp.Format("2006-01-02 15:04:05"),
+				labelStr)
 		}
-		labelStr := "N/A"
-		if len(labels) > 0 {
-			labelStr = strings.Join(labels, ",")
+		if pending {
+			podCounts.printLate(names)
 		}
-
-		fmt.Printf("%-30s %-15s %-15s %-20s %-20s\n",
-			ns.Name,
-			string(ns.Status.Phase),
-			podCount,
-			ns.CreationTimestamp.Format("2006-01-02 15:04:05"),
-			labelStr)
+	})
+	if apierrors.IsForbidden(err) {
+		// Yalnızca kendi namespace'inde yetkisi olan kullanıcılar için
+		fmt.Println(i18n.T("\nNamespace listeleme yetkiniz yok."))
+		if namespace := s.DefaultNamespace(); namespace != "" {
+			i18n.Printf("Çalışılan namespace: %s\n", namespace)
+		}
+		return
 	}
-}
-
-func listNodes() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	nodes, err := auth.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		fmt.Printf("Node listesi alınamadı: %v\n", err)
-		return
+		i18n.Printf("Namespace listesi alınamadı: %v\n", err)
 	}
+}
 
-	fmt.Println("\nNode Listesi:")
-	fmt.Printf("\n%-20s %-12s %-15s %-15s %-15s %-15s\n",
-		"İSİM", "DURUM", "CPU", "MEMORY", "POD SAYISI", "OS")
+func listNodes(s *session.Session) {
+	// Pod sayıları yalnızca tabloda gösterilir; sayım liste alınırken arka
+	// planda yapılır ve sayfa sayımı beklemeden yazılır
+	var podCounts *asyncPodCounts
+	if output.IsTable(s.Settings.Output) {
+		podCounts = podCountsAsync(s, podNode)
+	}
+	err := browsePages(NewPager(s, Nodes, metav1.ListOptions{}), func(nodes *corev1.NodeList) {
+		if printFormatted(s, nodes, NodeColumns, false) {
+			return
+		}
 
-	for _, node := range nodes.Items {
-		// Node durumunu kontrol et
-		status := "NotReady"
-		for _, condition := range node.Status.Conditions {
-			if condition.Type == "Ready" {
-				if condition.Status == "True" {
-					status = "Ready"
+		pending := !podCounts.ready()
+		fmt.Println(i18n.T("\nNode Listesi:"))
+		fmt.Printf("\n%-20s %-12s %-15s %-15s %-15s %-15s\n",
+			i18n.T("İSİM"), i18n.T("DURUM"), "CPU", "MEMORY", i18n.T("POD SAYISI"), "OS")
+
+		names := make([]string, 0, len(nodes.Items))
+		for _, node := range nodes.Items {
+			names = append(names, node.Name)
+
+			// Node durumunu kontrol et
+			status := "NotReady"
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == "Ready" {
+					if condition.Status == "True" {
+						status = "Ready"
+					}
+					break
 				}
-				break
 			}
-		}
 
-		// Pod sayısını al
-		fieldSelector := fmt.Sprintf("spec.nodeName=%s", node.Name)
-		pods, _ := auth.KubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-			FieldSelector: fieldSelector,
-		})
-
-		// Kaynak kullanımını hesapla
-		allocatableCPU := node.Status.Allocatable.Cpu().String()
-		allocatableMemory := node.Status.Allocatable.Memory().String()
-
-		fmt.Printf("%-20s %-12s %-15s %-15s %-15d %-15s\n",
-			node.Name,
-			status,
-			allocatableCPU,
-			allocatableMemory,
-			len(pods.Items),
-			node.Status.NodeInfo.OSImage)
-
-		// Detaylı bilgileri göster
-		fmt.Printf("  Kernel Version: %s\n", node.Status.NodeInfo.KernelVersion)
-		fmt.Printf("  Container Runtime: %s\n", node.Status.NodeInfo.ContainerRuntimeVersion)
-		fmt.Printf("  Kubelet Version: %s\n", node.Status.NodeInfo.KubeletVersion)
-		fmt.Printf("  Architecture: %s\n", node.Status.NodeInfo.Architecture)
-		fmt.Println()
-	}
-}
+			// Kaynak kullanımını hesapla
+			allocatableCPU := node.Status.Allocatable.Cpu().String()
+			allocatableMemory := node.Status.Allocatable.Memory().String()
 
-func ListPods() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+			fmt.Printf("%-20s %-12s %-15s %-15s %-15s %-15s\n",
+				node.Name,
+				status,
+				allocatableCPU,
+				allocatableMemory,
+				podCounts.cell(node.Name),
+				node.Status.NodeInfo.OSImage)
 
-	pods, err := auth.KubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+			// Detaylı bilgileri göster
+			fmt.Printf("  Kernel Version: %s\n", node.Status.NodeInfo.KernelVersion)
+			fmt.Printf("  Container Runtime: %s\n", node.Status.NodeInfo.ContainerRuntimeVersion)
+			fmt.Printf("  Kubelet Version: %s\n", node.Status.NodeInfo.KubeletVersion)
+			fmt.Printf("  Architecture: %s\n", node.Status.NodeInfo.Architecture)
+			fmt.Println()
+		}
+		if pending {
+			podCounts.printLate(names)
+		}
+	})
 	if err != nil {
-		fmt.Printf("Pod listesi alınamadı: %v\n", err)
-		return
+		i18n.Printf("Node listesi alınamadı: %v\n", err)
 	}
+}
 
-	fmt.Println("\nPod Listesi:")
-	fmt.Printf("%-5s %-30s %-15s %-12s %-15s %-15s\n",
-		"NO", "İSİM", "NAMESPACE", "DURUM", "NODE", "IP")
+func ListPods(s *session.Session) {
+	prompt := i18n.T("\nPod detayları için pod numarası girin (0 için ana menü): ")
+	pods, index, err := Browse(NewPager(s, Pods, metav1.ListOptions{}), prompt, func(pods *corev1.PodList) bool {
+		if printFormatted(s, pods, PodColumns, true) {
+			return false
+		}
 
-	podList := make([]corev1.Pod, 0)
-	for i, pod := range pods.Items {
-		fmt.Printf("%-5d %-30s %-15s %-12s %-15s %-15s\n",
-			i+1,
-			pod.Name,
-			pod.Namespace,
-			string(pod.Status.Phase),
-			pod.Spec.NodeName,
-			pod.Status.PodIP)
-		podList = append(podList, pod)
+		fmt.Println(i18n.T("\nPod Listesi:"))
+		fmt.Printf("%-5s %-30s %-15s %-12s %-15s %-15s\n",
+			"NO", i18n.T("İSİM"), "NAMESPACE", i18n.T("DURUM"), "NODE", "IP")
+
+		for i, pod := range pods.Items {
+			fmt.Printf("%-5d %-30s %-15s %-12s %-15s %-15s\n",
+				i+1,
+				pod.Name,
+				pod.Namespace,
+				string(pod.Status.Phase),
+				pod.Spec.NodeName,
+				pod.Status.PodIP)
+		}
+		return true
+	})
+	if err != nil {
+		i18n.Printf("Pod listesi alınamadı: %v\n", err)
+		return
 	}
-
-	fmt.Print("\nPod detayları için pod numarası girin (0 için ana menü): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
-
-	if choice > 0 && choice <= len(podList) {
-		ShowPodDetails(podList[choice-1])
+	if index >= 0 {
+		ShowPodDetails(s, pods.Items[index])
 	}
 }
 
 // ShowPodDetails - Pod detaylarını gösteren ana menü fonksiyonu
-func ShowPodDetails(pod corev1.Pod) {
+func ShowPodDetails(s *session.Session, pod corev1.Pod) {
 	for {
 		// Her menü gösteriminde güncel pod bilgilerini al
-		updatedPod, err := auth.KubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+		updatedPod, err := s.Client.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
 		if err != nil {
-			fmt.Printf("Pod bilgileri alınamadı: %v\n", err)
+			i18n.Printf("Pod bilgileri alınamadı: %v\n", err)
 			return
 		}
 
-		fmt.Printf("\n=== Pod Detayları: %s ===\n", updatedPod.Name)
-		fmt.Println("1. Genel Bilgiler")
-		fmt.Println("2. Container Durumları")
-		fmt.Println("3. Son Loglar")
-		fmt.Println("4. Canlı Log Takibi")
+		i18n.Printf("\n=== Pod Detayları: %s ===\n", updatedPod.Name)
+		fmt.Println(i18n.T("1. Genel Bilgiler"))
+		fmt.Println(i18n.T("2. Container Durumları"))
+		fmt.Println(i18n.T("3. Son Loglar"))
+		fmt.Println(i18n.T("4. Canlı Log Takibi"))
 		fmt.Println("5. Events")
-		fmt.Println("6. Önceki Menü")
-		fmt.Print("Seçiminiz (1-6): ")
+		fmt.Println(i18n.T("6. Önceki Menü"))
+		fmt.Print(i18n.T("Seçiminiz (1-6): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -257,45 +291,45 @@ func ShowPodDetails(pod corev1.Pod) {
 		case 2:
 			ShowContainerStatuses(updatedPod)
 		case 3:
-			GetPodLogs(updatedPod.Name, updatedPod.Namespace, false)
+			GetPodLogs(s, updatedPod.Name, updatedPod.Namespace, false)
 		case 4:
-			GetPodLogs(updatedPod.Name, updatedPod.Namespace, true)
+			GetPodLogs(s, updatedPod.Name, updatedPod.Namespace, true)
 		case 5:
-			GetPodEvents(updatedPod)
+			GetPodEvents(s, updatedPod)
 		case 6:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
 // ShowPodInfo - Pod bilgilerini gösteren fonksiyon
 func ShowPodInfo(pod *corev1.Pod) {
-	fmt.Printf("\nPod Bilgileri:\n")
-	fmt.Printf("Pod Adı: %s\n", pod.Name)
+	i18n.Printf("\nPod Bilgileri:\n")
+	i18n.Printf("Pod Adı: %s\n", pod.Name)
 	fmt.Printf("Namespace: %s\n", pod.Namespace)
 	fmt.Printf("Node: %s\n", pod.Spec.NodeName)
 	fmt.Printf("IP: %s\n", pod.Status.PodIP)
 
 	// Oluşturulma zamanını Türkiye saati olarak göster
 	if pod.CreationTimestamp.Time.IsZero() {
-		fmt.Println("Oluşturulma: Bilgi yok")
+		fmt.Println(i18n.T("Oluşturulma: Bilgi yok"))
 	} else {
 		localTime := pod.CreationTimestamp.Time.Local()
-		fmt.Printf("Oluşturulma: %s\n", localTime.Format("2006-01-02 15:04:05"))
+		i18n.Printf("Oluşturulma: %s\n", localTime.Format("2006-01-02 15:04:05"))
 	}
 
-	fmt.Printf("Durum: %s\n", pod.Status.Phase)
+	i18n.Printf("Durum: %s\n", pod.Status.Phase)
 
 	// QoS Sınıfı
-	fmt.Printf("QoS Sınıfı: %s\n", pod.Status.QOSClass)
+	i18n.Printf("QoS Sınıfı: %s\n", pod.Status.QOSClass)
 
-	fmt.Printf("\nContainer'lar:\n")
+	i18n.Printf("\nContainer'lar:\n")
 	for _, container := range pod.Spec.Containers {
 		fmt.Printf("- %s (Image: %s)\n", container.Name, container.Image)
 		if len(container.Ports) > 0 {
-			fmt.Printf("  Portlar: ")
+			i18n.Printf("  Portlar: ")
 			for i, port := range container.Ports {
 				if i > 0 {
 					fmt.Print(", ")
@@ -308,19 +342,19 @@ func ShowPodInfo(pod *corev1.Pod) {
 }
 
 func ShowContainerStatuses(pod *corev1.Pod) {
-	fmt.Printf("\nContainer Durumları - Pod: %s\n", pod.Name)
+	i18n.Printf("\nContainer Durumları - Pod: %s\n", pod.Name)
 	for _, status := range pod.Status.ContainerStatuses {
 		fmt.Printf("\nContainer: %s\n", status.Name)
 		fmt.Printf("Ready: %v\n", status.Ready)
 		fmt.Printf("Restart Count: %d\n", status.RestartCount)
 
 		if status.State.Running != nil {
-			fmt.Printf("Durum: Running (Başlangıç: %s)\n",
+			i18n.Printf("Durum: Running (Başlangıç: %s)\n",
 				status.State.Running.StartedAt.Format("2006-01-02 15:04:05"))
 		} else if status.State.Waiting != nil {
-			fmt.Printf("Durum: Waiting (Sebep: %s)\n", status.State.Waiting.Reason)
+			i18n.Printf("Durum: Waiting (Sebep: %s)\n", status.State.Waiting.Reason)
 		} else if status.State.Terminated != nil {
-			fmt.Printf("Durum: Terminated (Sebep: %s, Kod: %d)\n",
+			i18n.Printf("Durum: Terminated (Sebep: %s, Kod: %d)\n",
 				status.State.Terminated.Reason,
 				status.State.Terminated.ExitCode)
 		}
@@ -328,32 +362,32 @@ func ShowContainerStatuses(pod *corev1.Pod) {
 }
 
 // GetPodLogs - Pod loglarını görüntüleyen fonksiyon
-func GetPodLogs(podName, namespace string, follow bool) {
+func GetPodLogs(s *session.Session, podName, namespace string, follow bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
 	defer cancel()
 
 	// Pod bilgilerini al
-	pod, err := auth.KubeClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	pod, err := s.Client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
-		fmt.Printf("Pod bilgileri alınamadı: %v\n", err)
+		i18n.Printf("Pod bilgileri alınamadı: %v\n", err)
 		return
 	}
 
 	// Birden fazla container varsa seçim yaptır
 	containerName := ""
 	if len(pod.Spec.Containers) > 1 {
-		fmt.Println("\nContainer Listesi:")
+		fmt.Println(i18n.T("\nContainer Listesi:"))
 		for i, container := range pod.Spec.Containers {
 			fmt.Printf("%d. %s\n", i+1, container.Name)
 		}
-		fmt.Print("\nContainer seçin (1-" + fmt.Sprint(len(pod.Spec.Containers)) + "): ")
+		i18n.Printf("\nContainer seçin (1-%d): ", len(pod.Spec.Containers))
 		var choice int
 		fmt.Scanf("%d", &choice)
 
 		if choice > 0 && choice <= len(pod.Spec.Containers) {
 			containerName = pod.Spec.Containers[choice-1].Name
 		} else {
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 			return
 		}
 	} else if len(pod.Spec.Containers) == 1 {
@@ -366,16 +400,8 @@ func GetPodLogs(podName, namespace string, follow bool) {
 		TailLines: utils.Int64(100),
 	}
 
-	req := auth.KubeClient.CoreV1().Pods(namespace).GetLogs(podName, &podLogOpts)
-	podLogs, err := req.Stream(ctx)
-	if err != nil {
-		fmt.Printf("Log stream açılamadı: %v\n", err)
-		return
-	}
-	defer podLogs.Close()
-
 	if follow {
-		fmt.Println("\nCanlı log takibi başladı. Çıkmak için 'q' tuşuna basın...")
+		fmt.Println(i18n.T("\nCanlı log takibi başladı. Çıkmak için 'q' tuşuna basın..."))
 
 		// Kullanıcı inputu için goroutine
 		go func() {
@@ -393,37 +419,68 @@ func GetPodLogs(podName, namespace string, follow bool) {
 		}()
 	}
 
+	if err := StreamPodLogs(ctx, s, namespace, podName, &podLogOpts, os.Stdout); err != nil {
+		i18n.Printf("Log stream açılamadı: %v\n", err)
+		return
+	}
+	if ctx.Err() != nil {
+		fmt.Println(i18n.T("\nLog takibi sonlandırıldı."))
+	}
+}
+
+// StreamPodLogs pod loglarını satır satır w'ye yazar. Follow açıksa ctx iptal
+// edilene kadar devam eder; iptal bir hata olarak döndürülmez.
+func StreamPodLogs(ctx context.Context, s *session.Session, namespace, podName string, opts *corev1.PodLogOptions, w io.Writer) error {
+	client, err := s.StreamingClient()
+	if err != nil {
+		return err
+	}
+	podLogs, err := client.CoreV1().Pods(namespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer podLogs.Close()
+
 	scanner := bufio.NewScanner(podLogs)
 	for scanner.Scan() {
-		select {
-		case <-ctx.Done():
-			fmt.Println("\nLog takibi sonlandırıldı.")
-			return
-		default:
-			fmt.Println(scanner.Text())
-			if !follow {
-				if scanner.Text() == "" {
-					break
-				}
-			}
-		}
+		fmt.Fprintln(w, scanner.Text())
 	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return scanner.Err()
 }
 
-func GetPodEvents(pod *corev1.Pod) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// DefaultContainer tek container'lı podlarda o container'ı, birden fazlaysa
+// kubectl'in varsayılan container anotasyonunu döndürür
+func DefaultContainer(pod *corev1.Pod) (string, error) {
+	if len(pod.Spec.Containers) == 1 {
+		return pod.Spec.Containers[0].Name, nil
+	}
+	if name := pod.Annotations["kubectl.kubernetes.io/default-container"]; name != "" {
+		return name, nil
+	}
+	names := make([]string, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+	return "", i18n.Errorf("pod birden fazla container içeriyor, birini seçin: %s", strings.Join(names, ", "))
+}
+
+func GetPodEvents(s *session.Session, pod *corev1.Pod) {
+	ctx, cancel := s.Context()
 	defer cancel()
 
-	events, err := auth.KubeClient.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
+	events, err := s.Client.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s", pod.Name),
 	})
 	if err != nil {
-		fmt.Printf("Events alınamadı: %v\n", err)
+		i18n.Printf("Events alınamadı: %v\n", err)
 		return
 	}
 
 	fmt.Printf("\nPod Events - %s:\n", pod.Name)
-	fmt.Printf("%-20s %-12s %-20s %s\n", "ZAMAN", "TİP", "SEBEP", "MESAJ")
+	fmt.Printf("%-20s %-12s %-20s %s\n", i18n.T("ZAMAN"), i18n.T("TİP"), i18n.T("SEBEP"), i18n.T("MESAJ"))
 	for _, event := range events.Items {
 		fmt.Printf("%-20s %-12s %-20s %s\n",
 			event.LastTimestamp.Format("2006-01-02 15:04:05"),
@@ -533,254 +590,254 @@ func GetPodEvents(pod *corev1.Pod) {
 		}
 	}
 */
-func listConfigMaps() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	configmaps, err := auth.KubeClient.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("ConfigMap listesi alınamadı: %v\n", err)
-		return
-	}
+func listConfigMaps(s *session.Session) {
+	err := browsePages(NewPager(s, ConfigMaps, metav1.ListOptions{}), func(configmaps *corev1.ConfigMapList) {
+		if printFormatted(s, configmaps, ConfigMapColumns, true) {
+			return
+		}
 
-	fmt.Println("\nConfigMap Listesi:")
-	fmt.Printf("%-30s %-20s %-10s %-20s\n", "İSİM", "NAMESPACE", "DATA", "AGE")
+		fmt.Println(i18n.T("\nConfigMap Listesi:"))
+		fmt.Printf("%-30s %-20s %-10s %-20s\n", i18n.T("İSİM"), "NAMESPACE", "DATA", "AGE")
 
-	for _, cm := range configmaps.Items {
-		age := time.Since(cm.CreationTimestamp.Time).Round(time.Second)
-		fmt.Printf("%-30s %-20s %-10d %-20s\n",
-			cm.Name,
-			cm.Namespace,
-			len(cm.Data),
-			age.String())
+		for _, cm := range configmaps.Items {
+			age := time.Since(cm.CreationTimestamp.Time).Round(time.Second)
+			fmt.Printf("%-30s %-20s %-10d %-20s\n",
+				cm.Name,
+				cm.Namespace,
+				len(cm.Data),
+				age.String())
 
-		// ConfigMap içeriğini göster
-		if len(cm.Data) > 0 {
-			fmt.Println("  Data Keys:")
-			for key := range cm.Data {
-				fmt.Printf("    - %s\n", key)
+			// ConfigMap içeriğini göster
+			if len(cm.Data) > 0 {
+				fmt.Println("  Data Keys:")
+				for key := range cm.Data {
+					fmt.Printf("    - %s\n", key)
+				}
 			}
+			fmt.Println()
 		}
-		fmt.Println()
+	})
+	if err != nil {
+		i18n.Printf("ConfigMap listesi alınamadı: %v\n", err)
 	}
 }
 
-func listSecrets() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	secrets, err := auth.KubeClient.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Secret listesi alınamadı: %v\n", err)
-		return
-	}
+func listSecrets(s *session.Session) {
+	err := browsePages(NewPager(s, Secrets, metav1.ListOptions{}), func(secrets *corev1.SecretList) {
+		if printFormatted(s, secrets, SecretColumns, true) {
+			return
+		}
 
-	fmt.Println("\nSecret Listesi:")
-	fmt.Printf("%-30s %-20s %-15s %-10s %-20s\n", "İSİM", "NAMESPACE", "TYPE", "DATA", "AGE")
+		fmt.Println(i18n.T("\nSecret Listesi:"))
+		fmt.Printf("%-30s %-20s %-15s %-10s %-20s\n", i18n.T("İSİM"), "NAMESPACE", "TYPE", "DATA", "AGE")
 
-	for _, secret := range secrets.Items {
-		age := time.Since(secret.CreationTimestamp.Time).Round(time.Second)
-		fmt.Printf("%-30s %-20s %-15s %-10d %-20s\n",
-			secret.Name,
-			secret.Namespace,
-			secret.Type,
-			len(secret.Data),
-			age.String())
+		for _, secret := range secrets.Items {
+			age := time.Since(secret.CreationTimestamp.Time).Round(time.Second)
+			fmt.Printf("%-30s %-20s %-15s %-10d %-20s\n",
+				secret.Name,
+				secret.Namespace,
+				secret.Type,
+				len(secret.Data),
+				age.String())
 
-		// Secret key'lerini göster (değerleri göstermeden)
-		if len(secret.Data) > 0 {
-			fmt.Println("  Data Keys:")
-			for key := range secret.Data {
-				fmt.Printf("    - %s\n", key)
+			// Secret key'lerini göster (değerleri göstermeden)
+			if len(secret.Data) > 0 {
+				fmt.Println("  Data Keys:")
+				for key := range secret.Data {
+					fmt.Printf("    - %s\n", key)
+				}
 			}
+			fmt.Println()
 		}
-		fmt.Println()
-	}
-}
-
-func listPersistentVolumes() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	pvs, err := auth.KubeClient.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
-		fmt.Printf("PersistentVolume listesi alınamadı: %v\n", err)
-		return
+		i18n.Printf("Secret listesi alınamadı: %v\n", err)
 	}
+}
 
-	fmt.Println("\nPersistentVolume Listesi:")
-	fmt.Printf("%-30s %-15s %-15s %-15s %-15s\n", "İSİM", "CAPACITY", "ACCESS MODES", "STATUS", "CLAIM")
-
-	for _, pv := range pvs.Items {
-		claim := "N/A"
-		if pv.Spec.ClaimRef != nil {
-			claim = fmt.Sprintf("%s/%s", pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+func listPersistentVolumes(s *session.Session) {
+	err := browsePages(NewPager(s, PersistentVolumes, metav1.ListOptions{}), func(pvs *corev1.PersistentVolumeList) {
+		if printFormatted(s, pvs, PersistentVolumeColumns, false) {
+			return
 		}
 
-		fmt.Printf("%-30s %-15s %-15s %-15s %-15s\n",
-			pv.Name,
-			pv.Spec.Capacity.Storage().String(),
-			accessModesToString(pv.Spec.AccessModes),
-			string(pv.Status.Phase),
-			claim)
+		fmt.Println(i18n.T("\nPersistentVolume Listesi:"))
+		fmt.Printf("%-30s %-15s %-15s %-15s %-15s\n", i18n.T("İSİM"), "CAPACITY", "ACCESS MODES", "STATUS", "CLAIM")
 
-		// Storage class ve diğer detayları göster
-		fmt.Printf("  StorageClass: %s\n", pv.Spec.StorageClassName)
-		fmt.Printf("  Reclaim Policy: %s\n", pv.Spec.PersistentVolumeReclaimPolicy)
-		fmt.Println()
-	}
-}
+		for _, pv := range pvs.Items {
+			claim := "N/A"
+			if pv.Spec.ClaimRef != nil {
+				claim = fmt.Sprintf("%s/%s", pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+			}
 
-func listPersistentVolumeClaims() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+			fmt.Printf("%-30s %-15s %-15s %-15s %-15s\n",
+				pv.Name,
+				pv.Spec.Capacity.Storage().String(),
+				accessModesToString(pv.Spec.AccessModes),
+				string(pv.Status.Phase),
+				claim)
 
-	pvcs, err := auth.KubeClient.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+			// Storage class ve diğer detayları göster
+			fmt.Printf("  StorageClass: %s\n", pv.Spec.StorageClassName)
+			fmt.Printf("  Reclaim Policy: %s\n", pv.Spec.PersistentVolumeReclaimPolicy)
+			fmt.Println()
+		}
+	})
 	if err != nil {
-		fmt.Printf("PersistentVolumeClaim listesi alınamadı: %v\n", err)
-		return
+		i18n.Printf("PersistentVolume listesi alınamadı: %v\n", err)
 	}
+}
 
-	fmt.Println("\nPersistentVolumeClaim Listesi:")
-	fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n", "İSİM", "NAMESPACE", "STATUS", "VOLUME", "CAPACITY")
-
-	for _, pvc := range pvcs.Items {
-		capacity := "N/A"
-		if pvc.Status.Capacity != nil {
-			capacity = pvc.Status.Capacity.Storage().String()
+func listPersistentVolumeClaims(s *session.Session) {
+	err := browsePages(NewPager(s, PersistentVolumeClaims, metav1.ListOptions{}), func(pvcs *corev1.PersistentVolumeClaimList) {
+		if printFormatted(s, pvcs, PersistentVolumeClaimColumns, true) {
+			return
 		}
 
-		fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n",
-			pvc.Name,
-			pvc.Namespace,
-			string(pvc.Status.Phase),
-			pvc.Spec.VolumeName,
-			capacity)
+		fmt.Println(i18n.T("\nPersistentVolumeClaim Listesi:"))
+		fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n", i18n.T("İSİM"), "NAMESPACE", "STATUS", "VOLUME", "CAPACITY")
 
-		fmt.Printf("  StorageClass: %s\n", *pvc.Spec.StorageClassName)
-		fmt.Printf("  Access Modes: %s\n", accessModesToString(pvc.Spec.AccessModes))
-		fmt.Println()
-	}
-}
+		for _, pvc := range pvcs.Items {
+			capacity := "N/A"
+			if pvc.Status.Capacity != nil {
+				capacity = pvc.Status.Capacity.Storage().String()
+			}
 
-func listStatefulSets() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+			fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n",
+				pvc.Name,
+				pvc.Namespace,
+				string(pvc.Status.Phase),
+				pvc.Spec.VolumeName,
+				capacity)
 
-	statefulsets, err := auth.KubeClient.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("StatefulSet listesi alınamadı: %v\n", err)
-		return
-	}
-
-	fmt.Println("\nStatefulSet Listesi:")
-	fmt.Printf("%-30s %-20s %-10s %-15s %-15s\n", "İSİM", "NAMESPACE", "READY", "AGE", "SERVICE NAME")
-
-	for _, sts := range statefulsets.Items {
-		age := time.Since(sts.CreationTimestamp.Time).Round(time.Second)
-		fmt.Printf("%-30s %-20s %d/%d %-15s %-15s\n",
-			sts.Name,
-			sts.Namespace,
-			sts.Status.ReadyReplicas,
-			sts.Status.Replicas,
-			age.String(),
-			sts.Spec.ServiceName)
-
-		// Volume claim templates
-		if len(sts.Spec.VolumeClaimTemplates) > 0 {
-			fmt.Println("  Volume Claim Templates:")
-			for _, vct := range sts.Spec.VolumeClaimTemplates {
-				fmt.Printf("    - %s (%s)\n", vct.Name, vct.Spec.Resources.Requests.Storage().String())
-			}
+			fmt.Printf("  StorageClass: %s\n", *pvc.Spec.StorageClassName)
+			fmt.Printf("  Access Modes: %s\n", accessModesToString(pvc.Spec.AccessModes))
+			fmt.Println()
 		}
-		fmt.Println()
+	})
+	if err != nil {
+		i18n.Printf("PersistentVolumeClaim listesi alınamadı: %v\n", err)
 	}
 }
 
-func listDaemonSets() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+func listStatefulSets(s *session.Session) {
+	err := browsePages(NewPager(s, StatefulSets, metav1.ListOptions{}), func(statefulsets *appsv1.StatefulSetList) {
+		if printFormatted(s, statefulsets, StatefulSetColumns, true) {
+			return
+		}
 
-	daemonsets, err := auth.KubeClient.AppsV1().DaemonSets("").List(ctx, metav1.ListOptions{})
+		fmt.Println(i18n.T("\nStatefulSet Listesi:"))
+		fmt.Printf("%-30s %-20s %-10s %-15s %-15s\n", i18n.T("İSİM"), "NAMESPACE", "READY", "AGE", "SERVICE NAME")
+
+		for _, sts := range statefulsets.Items {
+			age := time.Since(sts.CreationTimestamp.Time).Round(time.Second)
+			fmt.Printf("%-30s %-20s %d/%d %-15s %-15s\n",
+				sts.Name,
+				sts.Namespace,
+				sts.Status.ReadyReplicas,
+				sts.Status.Replicas,
+				age.String(),
+				sts.Spec.ServiceName)
+
+			// Volume claim templates
+			if len(sts.Spec.VolumeClaimTemplates) > 0 {
+				fmt.Println("  Volume Claim Templates:")
+				for _, vct := range sts.Spec.VolumeClaimTemplates {
+					fmt.Printf("    - %s (%s)\n", vct.Name, vct.Spec.Resources.Requests.Storage().String())
+				}
+			}
+			fmt.Println()
+		}
+	})
 	if err != nil {
-		fmt.Printf("DaemonSet listesi alınamadı: %v\n", err)
-		return
+		i18n.Printf("StatefulSet listesi alınamadı: %v\n", err)
 	}
+}
 
-	fmt.Println("\nDaemonSet Listesi:")
-	fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n", "İSİM", "NAMESPACE", "DESIRED", "CURRENT", "READY")
-
-	for _, ds := range daemonsets.Items {
-		fmt.Printf("%-30s %-20s %-15d %-15d %-15d\n",
-			ds.Name,
-			ds.Namespace,
-			ds.Status.DesiredNumberScheduled,
-			ds.Status.CurrentNumberScheduled,
-			ds.Status.NumberReady)
+func listDaemonSets(s *session.Session) {
+	err := browsePages(NewPager(s, DaemonSets, metav1.ListOptions{}), func(daemonsets *appsv1.DaemonSetList) {
+		if printFormatted(s, daemonsets, DaemonSetColumns, true) {
+			return
+		}
 
-		// Node selector bilgilerini göster
-		if len(ds.Spec.Template.Spec.NodeSelector) > 0 {
-			fmt.Println("  Node Selectors:")
-			for key, value := range ds.Spec.Template.Spec.NodeSelector {
-				fmt.Printf("    %s: %s\n", key, value)
+		fmt.Println(i18n.T("\nDaemonSet Listesi:"))
+		fmt.Printf("%-30s %-20s %-15s %-15s %-15s\n", i18n.T("İSİM"), "NAMESPACE", "DESIRED", "CURRENT", "READY")
+
+		for _, ds := range daemonsets.Items {
+			fmt.Printf("%-30s %-20s %-15d %-15d %-15d\n",
+				ds.Name,
+				ds.Namespace,
+				ds.Status.DesiredNumberScheduled,
+				ds.Status.CurrentNumberScheduled,
+				ds.Status.NumberReady)
+
+			// Node selector bilgilerini göster
+			if len(ds.Spec.Template.Spec.NodeSelector) > 0 {
+				fmt.Println("  Node Selectors:")
+				for key, value := range ds.Spec.Template.Spec.NodeSelector {
+					fmt.Printf("    %s: %s\n", key, value)
+				}
 			}
+			fmt.Println()
 		}
-		fmt.Println()
+	})
+	if err != nil {
+		i18n.Printf("DaemonSet listesi alınamadı: %v\n", err)
 	}
 }
 
-func listIngresses() {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	ingresses, err := auth.KubeClient.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Ingress listesi alınamadı: %v\n", err)
-		return
-	}
+func listIngresses(s *session.Session) {
+	err := browsePages(NewPager(s, Ingresses, metav1.ListOptions{}), func(ingresses *networkingv1.IngressList) {
+		if printFormatted(s, ingresses, IngressColumns, true) {
+			return
+		}
 
-	fmt.Println("\nIngress Listesi:")
-	fmt.Printf("%-30s %-20s %-20s %-30s\n", "İSİM", "NAMESPACE", "CLASS", "HOSTS")
+		fmt.Println(i18n.T("\nIngress Listesi:"))
+		fmt.Printf("%-30s %-20s %-20s %-30s\n", i18n.T("İSİM"), "NAMESPACE", "CLASS", "HOSTS")
 
-	for _, ing := range ingresses.Items {
-		ingressClass := "N/A"
-		if ing.Spec.IngressClassName != nil {
-			ingressClass = *ing.Spec.IngressClassName
-		}
+		for _, ing := range ingresses.Items {
+			ingressClass := "N/A"
+			if ing.Spec.IngressClassName != nil {
+				ingressClass = *ing.Spec.IngressClassName
+			}
 
-		hosts := []string{}
-		for _, rule := range ing.Spec.Rules {
-			hosts = append(hosts, rule.Host)
-		}
+			hosts := []string{}
+			for _, rule := range ing.Spec.Rules {
+				hosts = append(hosts, rule.Host)
+			}
 
-		fmt.Printf("%-30s %-20s %-20s %-30s\n",
-			ing.Name,
-			ing.Namespace,
-			ingressClass,
-			strings.Join(hosts, ","))
-
-		// TLS ve Path bilgilerini göster
-		if len(ing.Spec.TLS) > 0 {
-			fmt.Println("  TLS:")
-			for _, tls := range ing.Spec.TLS {
-				fmt.Printf("    - Secret Name: %s\n", tls.SecretName)
-				fmt.Printf("      Hosts: %s\n", strings.Join(tls.Hosts, ", "))
+			fmt.Printf("%-30s %-20s %-20s %-30s\n",
+				ing.Name,
+				ing.Namespace,
+				ingressClass,
+				strings.Join(hosts, ","))
+
+			// TLS ve Path bilgilerini göster
+			if len(ing.Spec.TLS) > 0 {
+				fmt.Println("  TLS:")
+				for _, tls := range ing.Spec.TLS {
+					fmt.Printf("    - Secret Name: %s\n", tls.SecretName)
+					fmt.Printf("      Hosts: %s\n", strings.Join(tls.Hosts, ", "))
+				}
 			}
-		}
 
-		fmt.Println("  Rules:")
-		for _, rule := range ing.Spec.Rules {
-			fmt.Printf("    - Host: %s\n", rule.Host)
-			if rule.HTTP != nil {
-				for _, path := range rule.HTTP.Paths {
-					fmt.Printf("      Path: %s -> %s:%d\n",
-						path.Path,
-						path.Backend.Service.Name,
-						path.Backend.Service.Port.Number)
+			fmt.Println("  Rules:")
+			for _, rule := range ing.Spec.Rules {
+				fmt.Printf("    - Host: %s\n", rule.Host)
+				if rule.HTTP != nil {
+					for _, path := range rule.HTTP.Paths {
+						fmt.Printf("      Path: %s -> %s:%d\n",
+							path.Path,
+							path.Backend.Service.Name,
+							path.Backend.Service.Port.Number)
+					}
 				}
 			}
+			fmt.Println()
 		}
-		fmt.Println()
+	})
+	if err != nil {
+		i18n.Printf("Ingress listesi alınamadı: %v\n", err)
 	}
 }
 
@@ -793,90 +850,86 @@ func accessModesToString(modes []corev1.PersistentVolumeAccessMode) string {
 	return strings.Join(strs, ",")
 }
 
-func ListDeploymentsWithDetails() {
-	ctx := context.Background()
-	deployments, err := auth.KubeClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+func ListDeploymentsWithDetails(s *session.Session) {
+	prompt := i18n.T("\nDeployment detayları için numara girin (0 için geri dön): ")
+	deployments, index, err := Browse(NewPager(s, Deployments, metav1.ListOptions{}), prompt, func(deployments *appsv1.DeploymentList) bool {
+		if printFormatted(s, deployments, DeploymentColumns, true) {
+			return false
+		}
+
+		fmt.Println(i18n.T("\nDeployment Listesi:"))
+		fmt.Printf("%-5s %-30s %-15s %-10s %-10s %-10s\n",
+			"NO", i18n.T("İSİM"), "NAMESPACE", "READY", "UP-TO-DATE", "AVAILABLE")
+
+		for i, deploy := range deployments.Items {
+			fmt.Printf("%-5d %-30s %-15s %d/%d     %-10d %-10d\n",
+				i+1,
+				deploy.Name,
+				deploy.Namespace,
+				deploy.Status.ReadyReplicas,
+				deploy.Status.Replicas,
+				deploy.Status.UpdatedReplicas,
+				deploy.Status.AvailableReplicas)
+		}
+		return true
+	})
 	if err != nil {
-		fmt.Printf("Deployment listesi alınamadı: %v\n", err)
+		i18n.Printf("Deployment listesi alınamadı: %v\n", err)
 		return
 	}
-
-	fmt.Println("\nDeployment Listesi:")
-	fmt.Printf("%-5s %-30s %-15s %-10s %-10s %-10s\n",
-		"NO", "İSİM", "NAMESPACE", "READY", "UP-TO-DATE", "AVAILABLE")
-
-	deployList := make([]appsv1.Deployment, 0)
-	for i, deploy := range deployments.Items {
-		fmt.Printf("%-5d %-30s %-15s %d/%d     %-10d %-10d\n",
-			i+1,
-			deploy.Name,
-			deploy.Namespace,
-			deploy.Status.ReadyReplicas,
-			deploy.Status.Replicas,
-			deploy.Status.UpdatedReplicas,
-			deploy.Status.AvailableReplicas)
-		deployList = append(deployList, deploy)
+	if index >= 0 {
+		ShowDeploymentDetails(s, deployments.Items[index])
 	}
+}
 
-	fmt.Print("\nDeployment detayları için numara girin (0 için geri dön): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
+func ListServicesWithDetails(s *session.Session) {
+	prompt := i18n.T("\nService detayları için numara girin (0 için geri dön): ")
+	services, index, err := Browse(NewPager(s, Services, metav1.ListOptions{}), prompt, func(services *corev1.ServiceList) bool {
+		if printFormatted(s, services, ServiceColumns, true) {
+			return false
+		}
 
-	if choice > 0 && choice <= len(deployList) {
-		ShowDeploymentDetails(deployList[choice-1])
-	}
-}
+		fmt.Println(i18n.T("\nService Listesi:"))
+		fmt.Printf("%-5s %-30s %-15s %-10s %-15s\n",
+			"NO", i18n.T("İSİM"), "NAMESPACE", "TYPE", "CLUSTER-IP")
 
-func ListServicesWithDetails() {
-	ctx := context.Background()
-	services, err := auth.KubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+		for i, svc := range services.Items {
+			fmt.Printf("%-5d %-30s %-15s %-10s %-15s\n",
+				i+1,
+				svc.Name,
+				svc.Namespace,
+				svc.Spec.Type,
+				svc.Spec.ClusterIP)
+		}
+		return true
+	})
 	if err != nil {
-		fmt.Printf("Service listesi alınamadı: %v\n", err)
+		i18n.Printf("Service listesi alınamadı: %v\n", err)
 		return
 	}
-
-	fmt.Println("\nService Listesi:")
-	fmt.Printf("%-5s %-30s %-15s %-10s %-15s\n",
-		"NO", "İSİM", "NAMESPACE", "TYPE", "CLUSTER-IP")
-
-	svcList := make([]corev1.Service, 0)
-	for i, svc := range services.Items {
-		fmt.Printf("%-5d %-30s %-15s %-10s %-15s\n",
-			i+1,
-			svc.Name,
-			svc.Namespace,
-			svc.Spec.Type,
-			svc.Spec.ClusterIP)
-		svcList = append(svcList, svc)
-	}
-
-	fmt.Print("\nService detayları için numara girin (0 için geri dön): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
-
-	if choice > 0 && choice <= len(svcList) {
-		ShowServiceDetails(svcList[choice-1])
+	if index >= 0 {
+		ShowServiceDetails(s, services.Items[index])
 	}
 }
 
-func ShowDeploymentDetails(deploy appsv1.Deployment) {
+func ShowDeploymentDetails(s *session.Session, deploy appsv1.Deployment) {
 	for {
 		// Her seferinde güncel deployment bilgilerini al
-		updatedDeploy, err := auth.KubeClient.AppsV1().Deployments(deploy.Namespace).
+		updatedDeploy, err := s.Client.AppsV1().Deployments(deploy.Namespace).
 			Get(context.Background(), deploy.Name, metav1.GetOptions{})
 		if err != nil {
-			fmt.Printf("Deployment bilgileri alınamadı: %v\n", err)
+			i18n.Printf("Deployment bilgileri alınamadı: %v\n", err)
 			return
 		}
 
-		fmt.Printf("\n=== Deployment Detayları: %s ===\n", updatedDeploy.Name)
-		fmt.Println("1. Genel Bilgiler")
+		i18n.Printf("\n=== Deployment Detayları: %s ===\n", updatedDeploy.Name)
+		fmt.Println(i18n.T("1. Genel Bilgiler"))
 		fmt.Println("2. Pod Template")
-		fmt.Println("3. Replica Durumu")
-		fmt.Println("4. İlgili Podları Görüntüle")
+		fmt.Println(i18n.T("3. Replica Durumu"))
+		fmt.Println(i18n.T("4. İlgili Podları Görüntüle"))
 		fmt.Println("5. Events")
-		fmt.Println("6. Deployment Listesine Dön")
-		fmt.Print("Seçiminiz (1-6): ")
+		fmt.Println(i18n.T("6. Deployment Listesine Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-6): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -889,41 +942,41 @@ func ShowDeploymentDetails(deploy appsv1.Deployment) {
 		case 3:
 			showReplicaStatus(updatedDeploy)
 		case 4:
-			showDeploymentPods(updatedDeploy)
+			showDeploymentPods(s, updatedDeploy)
 		case 5:
-			getDeploymentEvents(updatedDeploy)
+			getDeploymentEvents(s, updatedDeploy)
 		case 6:
-			ListDeploymentsWithDetails() // Deployment listesine geri dön
+			ListDeploymentsWithDetails(s) // Deployment listesine geri dön
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func showDeploymentPods(deploy *appsv1.Deployment) {
+func showDeploymentPods(s *session.Session, deploy *appsv1.Deployment) {
 	// Deployment'a ait podları bul
 	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
 	if err != nil {
-		fmt.Printf("Label selector oluşturulamadı: %v\n", err)
+		i18n.Printf("Label selector oluşturulamadı: %v\n", err)
 		return
 	}
 
-	pods, err := auth.KubeClient.CoreV1().Pods(deploy.Namespace).List(context.Background(), metav1.ListOptions{
+	pods, err := s.Client.CoreV1().Pods(deploy.Namespace).List(context.Background(), metav1.ListOptions{
 		LabelSelector: selector.String(),
 	})
 	if err != nil {
-		fmt.Printf("Podlar alınamadı: %v\n", err)
+		i18n.Printf("Podlar alınamadı: %v\n", err)
 		return
 	}
 
 	if len(pods.Items) == 0 {
-		fmt.Println("\nBu deployment'a ait çalışan pod bulunamadı!")
+		fmt.Println(i18n.T("\nBu deployment'a ait çalışan pod bulunamadı!"))
 		return
 	}
 
-	fmt.Printf("\n%s Deployment'ına ait Podlar:\n", deploy.Name)
-	fmt.Printf("%-5s %-30s %-12s %-15s\n", "NO", "İSİM", "DURUM", "NODE")
+	i18n.Printf("\n%s Deployment'ına ait Podlar:\n", deploy.Name)
+	fmt.Printf("%-5s %-30s %-12s %-15s\n", "NO", i18n.T("İSİM"), i18n.T("DURUM"), "NODE")
 
 	podList := make([]corev1.Pod, 0)
 	for i, pod := range pods.Items {
@@ -935,17 +988,17 @@ func showDeploymentPods(deploy *appsv1.Deployment) {
 		podList = append(podList, pod)
 	}
 
-	fmt.Print("\nPod detayları için pod numarası girin (0 için geri dön): ")
+	fmt.Print(i18n.T("\nPod detayları için pod numarası girin (0 için geri dön): "))
 	var choice int
 	fmt.Scanf("%d", &choice)
 
 	if choice > 0 && choice <= len(podList) {
-		ShowPodDetails(podList[choice-1])
+		ShowPodDetails(s, podList[choice-1])
 	}
 }
 
 func showDeploymentInfo(deploy *appsv1.Deployment) {
-	fmt.Printf("\nDeployment Bilgileri - %s:\n", deploy.Name)
+	i18n.Printf("\nDeployment Bilgileri - %s:\n", deploy.Name)
 	fmt.Printf("  Strategy: %s\n", deploy.Spec.Strategy.Type)
 	if len(deploy.Spec.Template.Spec.Containers) > 0 {
 		fmt.Println("  Containers:")
@@ -990,7 +1043,7 @@ func showPodTemplate(deploy *appsv1.Deployment) {
 }
 
 func showReplicaStatus(deploy *appsv1.Deployment) {
-	fmt.Printf("\nReplica Durumu - %s:\n", deploy.Name)
+	i18n.Printf("\nReplica Durumu - %s:\n", deploy.Name)
 	fmt.Printf("  Ready Replicas: %d\n", deploy.Status.ReadyReplicas)
 	fmt.Printf("  Replicas: %d\n", deploy.Status.Replicas)
 	fmt.Printf("  Updated Replicas: %d\n", deploy.Status.UpdatedReplicas)
@@ -998,20 +1051,20 @@ func showReplicaStatus(deploy *appsv1.Deployment) {
 	fmt.Println()
 }
 
-func getDeploymentEvents(deploy *appsv1.Deployment) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func getDeploymentEvents(s *session.Session, deploy *appsv1.Deployment) {
+	ctx, cancel := s.Context()
 	defer cancel()
 
-	events, err := auth.KubeClient.CoreV1().Events(deploy.Namespace).List(ctx, metav1.ListOptions{
+	events, err := s.Client.CoreV1().Events(deploy.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s", deploy.Name),
 	})
 	if err != nil {
-		fmt.Printf("Events alınamadı: %v\n", err)
+		i18n.Printf("Events alınamadı: %v\n", err)
 		return
 	}
 
 	fmt.Printf("\nDeployment Events - %s:\n", deploy.Name)
-	fmt.Printf("%-20s %-12s %-20s %s\n", "ZAMAN", "TİP", "SEBEP", "MESAJ")
+	fmt.Printf("%-20s %-12s %-20s %s\n", i18n.T("ZAMAN"), i18n.T("TİP"), i18n.T("SEBEP"), i18n.T("MESAJ"))
 	for _, event := range events.Items {
 		fmt.Printf("%-20s %-12s %-20s %s\n",
 			event.LastTimestamp.Format("2006-01-02 15:04:05"),
@@ -1021,24 +1074,24 @@ func getDeploymentEvents(deploy *appsv1.Deployment) {
 	}
 }
 
-func ShowServiceDetails(svc corev1.Service) {
+func ShowServiceDetails(s *session.Session, svc corev1.Service) {
 	for {
 		// Her seferinde güncel service bilgilerini al
-		updatedSvc, err := auth.KubeClient.CoreV1().Services(svc.Namespace).
+		updatedSvc, err := s.Client.CoreV1().Services(svc.Namespace).
 			Get(context.Background(), svc.Name, metav1.GetOptions{})
 		if err != nil {
-			fmt.Printf("Service bilgileri alınamadı: %v\n", err)
+			i18n.Printf("Service bilgileri alınamadı: %v\n", err)
 			return
 		}
 
-		fmt.Printf("\n=== Service Detayları: %s ===\n", updatedSvc.Name)
-		fmt.Println("1. Genel Bilgiler")
-		fmt.Println("2. Port Bilgileri")
-		fmt.Println("3. Endpoint Bilgileri")
-		fmt.Println("4. Bağlı Podları Görüntüle")
+		i18n.Printf("\n=== Service Detayları: %s ===\n", updatedSvc.Name)
+		fmt.Println(i18n.T("1. Genel Bilgiler"))
+		fmt.Println(i18n.T("2. Port Bilgileri"))
+		fmt.Println(i18n.T("3. Endpoint Bilgileri"))
+		fmt.Println(i18n.T("4. Bağlı Podları Görüntüle"))
 		fmt.Println("5. Events")
-		fmt.Println("6. Service Listesine Dön")
-		fmt.Print("Seçiminiz (1-6): ")
+		fmt.Println(i18n.T("6. Service Listesine Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-6): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
@@ -1049,24 +1102,24 @@ func ShowServiceDetails(svc corev1.Service) {
 		case 2:
 			showServicePorts(updatedSvc)
 		case 3:
-			showServiceEndpoints(updatedSvc)
+			showServiceEndpoints(s, updatedSvc)
 		case 4:
-			showServicePods(updatedSvc)
+			showServicePods(s, updatedSvc)
 		case 5:
-			getServiceEvents(updatedSvc)
+			getServiceEvents(s, updatedSvc)
 		case 6:
-			ListServicesWithDetails()
+			ListServicesWithDetails(s)
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func showServicePods(svc *corev1.Service) {
+func showServicePods(s *session.Session, svc *corev1.Service) {
 	// Service'in selector'ını kullanarak bağlı podları bul
 	if len(svc.Spec.Selector) == 0 {
-		fmt.Println("\nBu service herhangi bir pod'a bağlı değil (selector bulunamadı)")
+		fmt.Println(i18n.T("\nBu service herhangi bir pod'a bağlı değil (selector bulunamadı)"))
 		return
 	}
 
@@ -1077,21 +1130,21 @@ func showServicePods(svc *corev1.Service) {
 	}
 
 	// Podları getir
-	pods, err := auth.KubeClient.CoreV1().Pods(svc.Namespace).List(context.Background(), metav1.ListOptions{
+	pods, err := s.Client.CoreV1().Pods(svc.Namespace).List(context.Background(), metav1.ListOptions{
 		LabelSelector: strings.Join(selectorString, ","),
 	})
 	if err != nil {
-		fmt.Printf("Podlar alınamadı: %v\n", err)
+		i18n.Printf("Podlar alınamadı: %v\n", err)
 		return
 	}
 
 	if len(pods.Items) == 0 {
-		fmt.Println("\nBu service'e bağlı çalışan pod bulunamadı!")
+		fmt.Println(i18n.T("\nBu service'e bağlı çalışan pod bulunamadı!"))
 		return
 	}
 
-	fmt.Printf("\n%s Service'ine Bağlı Podlar:\n", svc.Name)
-	fmt.Printf("%-5s %-30s %-12s %-15s %-15s\n", "NO", "İSİM", "DURUM", "NODE", "POD IP")
+	i18n.Printf("\n%s Service'ine Bağlı Podlar:\n", svc.Name)
+	fmt.Printf("%-5s %-30s %-12s %-15s %-15s\n", "NO", i18n.T("İSİM"), i18n.T("DURUM"), "NODE", "POD IP")
 
 	podList := make([]corev1.Pod, 0)
 	for i, pod := range pods.Items {
@@ -1104,32 +1157,32 @@ func showServicePods(svc *corev1.Service) {
 		podList = append(podList, pod)
 	}
 
-	fmt.Print("\nPod detayları için pod numarası girin (0 için geri dön): ")
+	fmt.Print(i18n.T("\nPod detayları için pod numarası girin (0 için geri dön): "))
 	var choice int
 	fmt.Scanf("%d", &choice)
 
 	if choice > 0 && choice <= len(podList) {
-		ShowPodDetails(podList[choice-1])
+		ShowPodDetails(s, podList[choice-1])
 	}
 }
 
-func showServiceEndpoints(svc *corev1.Service) {
-	endpoints, err := auth.KubeClient.CoreV1().Endpoints(svc.Namespace).Get(context.Background(), svc.Name, metav1.GetOptions{})
+func showServiceEndpoints(s *session.Session, svc *corev1.Service) {
+	endpoints, err := s.Client.CoreV1().Endpoints(svc.Namespace).Get(context.Background(), svc.Name, metav1.GetOptions{})
 	if err != nil {
-		fmt.Printf("Endpoint bilgileri alınamadı: %v\n", err)
+		i18n.Printf("Endpoint bilgileri alınamadı: %v\n", err)
 		return
 	}
 
-	fmt.Printf("\nEndpoint Bilgileri - %s:\n", svc.Name)
+	i18n.Printf("\nEndpoint Bilgileri - %s:\n", svc.Name)
 	if len(endpoints.Subsets) == 0 {
-		fmt.Println("Bu service için aktif endpoint bulunamadı")
+		fmt.Println(i18n.T("Bu service için aktif endpoint bulunamadı"))
 		return
 	}
 
 	for i, subset := range endpoints.Subsets {
 		fmt.Printf("\nSubset %d:\n", i+1)
 
-		fmt.Println("\nAktif Adresler:")
+		fmt.Println(i18n.T("\nAktif Adresler:"))
 		for _, addr := range subset.Addresses {
 			fmt.Printf("- IP: %s\n", addr.IP)
 			if addr.TargetRef != nil {
@@ -1138,7 +1191,7 @@ func showServiceEndpoints(svc *corev1.Service) {
 		}
 
 		if len(subset.NotReadyAddresses) > 0 {
-			fmt.Println("\nHazır Olmayan Adresler:")
+			fmt.Println(i18n.T("\nHazır Olmayan Adresler:"))
 			for _, addr := range subset.NotReadyAddresses {
 				fmt.Printf("- IP: %s\n", addr.IP)
 				if addr.TargetRef != nil {
@@ -1147,7 +1200,7 @@ func showServiceEndpoints(svc *corev1.Service) {
 			}
 		}
 
-		fmt.Println("\nPortlar:")
+		fmt.Println(i18n.T("\nPortlar:"))
 		for _, port := range subset.Ports {
 			fmt.Printf("- %d/%s", port.Port, port.Protocol)
 			if port.Name != "" {
@@ -1159,7 +1212,7 @@ func showServiceEndpoints(svc *corev1.Service) {
 }
 
 func showServicePorts(svc *corev1.Service) {
-	fmt.Printf("\nPort Yapılandırması - %s:\n", svc.Name)
+	i18n.Printf("\nPort Yapılandırması - %s:\n", svc.Name)
 	fmt.Printf("%-15s %-15s %-15s %-15s %-15s\n",
 		"PORT", "TARGET PORT", "NODE PORT", "PROTOCOL", "PORT NAME")
 
@@ -1183,20 +1236,20 @@ func showServicePorts(svc *corev1.Service) {
 	}
 }
 
-func getServiceEvents(svc *corev1.Service) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func getServiceEvents(s *session.Session, svc *corev1.Service) {
+	ctx, cancel := s.Context()
 	defer cancel()
 
-	events, err := auth.KubeClient.CoreV1().Events(svc.Namespace).List(ctx, metav1.ListOptions{
+	events, err := s.Client.CoreV1().Events(svc.Namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s", svc.Name),
 	})
 	if err != nil {
-		fmt.Printf("Events alınamadı: %v\n", err)
+		i18n.Printf("Events alınamadı: %v\n", err)
 		return
 	}
 
 	fmt.Printf("\nService Events - %s:\n", svc.Name)
-	fmt.Printf("%-20s %-12s %-20s %s\n", "ZAMAN", "TİP", "SEBEP", "MESAJ")
+	fmt.Printf("%-20s %-12s %-20s %s\n", i18n.T("ZAMAN"), i18n.T("TİP"), i18n.T("SEBEP"), i18n.T("MESAJ"))
 	for _, event := range events.Items {
 		fmt.Printf("%-20s %-12s %-20s %s\n",
 			event.LastTimestamp.Format("2006-01-02 15:04:05"),
@@ -1207,7 +1260,7 @@ func getServiceEvents(svc *corev1.Service) {
 }
 
 func showServiceInfo(svc *corev1.Service) {
-	fmt.Printf("\nService Bilgileri - %s:\n", svc.Name)
+	i18n.Printf("\nService Bilgileri - %s:\n", svc.Name)
 	fmt.Printf("  Type: %s\n", svc.Spec.Type)
 	fmt.Printf("  Cluster IP: %s\n", svc.Spec.ClusterIP)
 
@@ -0,0 +1,570 @@
+package info
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"tamerGoClient/internal/testutil"
+	"tamerGoClient/pkg/session"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+func pod(namespace, name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestListPods(t *testing.T) {
+	s := testutil.NewSession(pod("default", "web"), pod("client-access", "worker"))
+
+	out := testutil.RunWithInput(t, "0\n", func() { ListPods(s) })
+	for _, want := range []string{"web", "worker", "client-access", "Running"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("çıktıda %q bulunamadı:\n%s", want, out)
+		}
+	}
+}
+
+func TestListPodsNamespaceScoped(t *testing.T) {
+	s := testutil.NewSession(pod("default", "web"), pod("client-access", "worker"))
+	s.Namespace = "client-access"
+
+	out := testutil.RunWithInput(t, "0\n", func() { ListPods(s) })
+	if !strings.Contains(out, "worker") {
+		t.Errorf("namespace'teki pod listelenmedi:\n%s", out)
+	}
+	if strings.Contains(out, "web") {
+		t.Errorf("başka namespace'teki pod listelendi:\n%s", out)
+	}
+}
+
+func TestListPodsFallsBackToNamespace(t *testing.T) {
+	s := testutil.NewSession(pod("tenant", "mine"), pod("default", "other"))
+	s.FallbackNamespace = "tenant"
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", nil)
+		}
+		return false, nil, nil
+	})
+
+	out := testutil.RunWithInput(t, "0\n", func() { ListPods(s) })
+	if !strings.Contains(out, "mine") || strings.Contains(out, "other") {
+		t.Errorf("yalnızca tenant namespace'indeki pod listelenmeli:\n%s", out)
+	}
+	if s.Namespace != "tenant" {
+		t.Errorf("oturum yedek namespace'e daraltılmalıydı: %q", s.Namespace)
+	}
+}
+
+func TestListNamespacesCountsPods(t *testing.T) {
+	s := testutil.NewSession(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "client-access"}},
+		pod("client-access", "a"),
+		pod("client-access", "b"),
+	)
+
+	out := testutil.RunWithInput(t, "", func() { listNamespaces(s) })
+	if count := podCountIn(out, "client-access", 2); count != "2" {
+		t.Errorf("namespace için pod sayısı 2 beklendi, %q bulundu:\n%s", count, out)
+	}
+}
+
+// podCountIn name satırının column. sütunundaki pod sayısını döndürür. Sayfa
+// sayım bitmeden yazıldıysa sayı sayfanın altındaki özetten okunur.
+func podCountIn(out, name string, column int) string {
+	count := ""
+	for _, line := range strings.Split(out, "\n") {
+		if fields := strings.Fields(line); len(fields) >= column && fields[0] == name {
+			count = fields[column-1]
+		}
+		if summary, ok := strings.CutPrefix(line, "Pod sayıları: "); ok {
+			for _, pair := range strings.Split(summary, ", ") {
+				if value, ok := strings.CutPrefix(pair, name+"="); ok {
+					count = value
+				}
+			}
+		}
+	}
+	return count
+}
+
+func TestListNamespacesShowsPageBeforeCounts(t *testing.T) {
+	s := testutil.NewSession(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-access"},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}, pod("client-access", "a"))
+	release := make(chan struct{})
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		<-release
+		return false, nil, nil
+	})
+
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldStdout := os.Stdout
+	os.Stdout = stdoutWriter
+	defer func() { os.Stdout = oldStdout }()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		listNamespaces(s)
+		stdoutWriter.Close()
+	}()
+
+	// Sayım bloklanmışken satır yer tutucuyla yazılmalı
+	scanner := bufio.NewScanner(stdoutReader)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) >= 3 && fields[0] == "client-access" {
+			if fields[2] != "..." {
+				t.Errorf("sayım bitmeden yer tutucu gösterilmeli: %q", scanner.Text())
+			}
+			break
+		}
+	}
+	close(release)
+	rest, _ := io.ReadAll(stdoutReader)
+	<-done
+	if !strings.Contains(string(rest), "Pod sayıları: client-access=1") {
+		t.Errorf("sayım bitince pod sayısı sayfanın altına yazılmalı:\n%s", rest)
+	}
+}
+
+func TestPodCountsFallsBackToNamespaces(t *testing.T) {
+	objects := []runtime.Object{}
+	for _, name := range []string{"a", "b", "c"} {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, pod(name, "p1-"+name), pod(name, "p2-"+name))
+	}
+	s := testutil.NewSession(objects...)
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", nil)
+		}
+		return false, nil, nil
+	})
+
+	counts, err := PodCounts(s, podNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["a"] != 2 || counts["b"] != 2 || counts["c"] != 2 {
+		t.Errorf("namespace başına pod sayıları yanlış: %v", counts)
+	}
+	if calls := podListCalls(s); calls != 4 {
+		t.Errorf("cluster geneli ve her namespace için birer istek beklendi, %d istek yapıldı", calls)
+	}
+
+	// Bazı namespace'lerde de yetki yoksa sayılar eksik gösterilmemeli
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "b" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", nil)
+		}
+		return false, nil, nil
+	})
+	if _, err := PodCounts(s, podNamespace); !apierrors.IsForbidden(err) {
+		t.Errorf("yetkisiz namespace hatası dönmeliydi: %v", err)
+	}
+}
+
+func TestListDeploymentsWithDetails(t *testing.T) {
+	s := testutil.NewSession(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Status:     appsv1.DeploymentStatus{Replicas: 3, ReadyReplicas: 2},
+	})
+
+	out := testutil.RunWithInput(t, "0\n", func() { ListDeploymentsWithDetails(s) })
+	if !strings.Contains(out, "api") || !strings.Contains(out, "2/3") {
+		t.Errorf("deployment satırı beklenen biçimde değil:\n%s", out)
+	}
+}
+
+func TestListServicesWithDetails(t *testing.T) {
+	s := testutil.NewSession(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "10.0.0.10"},
+	})
+
+	out := testutil.RunWithInput(t, "0\n", func() { ListServicesWithDetails(s) })
+	for _, want := range []string{"frontend", "ClusterIP", "10.0.0.10"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("çıktıda %q bulunamadı:\n%s", want, out)
+		}
+	}
+}
+
+func TestShowServicePodsUsesSelector(t *testing.T) {
+	matching := pod("default", "backend-1")
+	matching.Labels = map[string]string{"app": "backend"}
+	other := pod("default", "frontend-1")
+	other.Labels = map[string]string{"app": "frontend"}
+	s := testutil.NewSession(matching, other)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "backend"}},
+	}
+	out := testutil.RunWithInput(t, "0\n", func() { showServicePods(s, svc) })
+	if !strings.Contains(out, "backend-1") || strings.Contains(out, "frontend-1") {
+		t.Errorf("yalnızca selector ile eşleşen pod listelenmeli:\n%s", out)
+	}
+}
+
+func TestHandleInfoMenuWithoutSession(t *testing.T) {
+	out := testutil.RunWithInput(t, "", func() { HandleInfoMenu(nil) })
+	if !strings.Contains(out, "Önce bir Kubernetes cluster'ına bağlanmalısınız") {
+		t.Errorf("bağlantı uyarısı bekleniyordu:\n%s", out)
+	}
+}
+
+func TestListPodsUsesSessionOutputFormat(t *testing.T) {
+	s := testutil.NewSession(pod("default", "web"))
+	s.Settings.Output = "csv"
+
+	// Makine okunur biçimde seçim adımı atlanır, girdi beklenmez
+	out := testutil.RunWithInput(t, "", func() { ListPods(s) })
+	if !strings.Contains(out, "NAMESPACE,İSİM,HAZIR") || !strings.Contains(out, "default,web,0/0,Running") {
+		t.Errorf("csv çıktısı bekleniyordu:\n%s", out)
+	}
+	if strings.Contains(out, "Pod detayları için") {
+		t.Errorf("seçim adımı atlanmalıydı:\n%s", out)
+	}
+}
+
+func TestSelectOutputFormat(t *testing.T) {
+	s := testutil.NewSession()
+
+	testutil.RunWithInput(t, "3\n", func() { selectOutputFormat(s) })
+	if s.Settings.Output != "json" {
+		t.Errorf("çıktı biçimi json olmalıydı: %q", s.Settings.Output)
+	}
+
+	out := testutil.RunWithInput(t, "7\n{.items[\n", func() { selectOutputFormat(s) })
+	if !strings.Contains(out, "geçersiz jsonpath") || s.Settings.Output != "json" {
+		t.Errorf("geçersiz şablon reddedilmeli (%q):\n%s", s.Settings.Output, out)
+	}
+}
+
+func TestPodsAppliesSessionFilter(t *testing.T) {
+	web := pod("default", "web-1")
+	web.Labels = map[string]string{"app": "web"}
+	webCanary := pod("default", "web-canary")
+	webCanary.Labels = map[string]string{"app": "web"}
+	api := pod("default", "api-1")
+	api.Labels = map[string]string{"app": "api"}
+	s := testutil.NewSession(web, webCanary, api)
+
+	s.Settings.Filter = session.Filter{LabelSelector: "app=web", Name: "/-[0-9]+$/"}
+	list, err := Pods(s, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "web-1" {
+		t.Errorf("yalnızca web-1 listelenmeli: %v", podNames(list.Items))
+	}
+
+	s.Settings.Filter = session.Filter{Name: "API"}
+	out := testutil.RunWithInput(t, "0\n", func() { ListPods(s) })
+	if !strings.Contains(out, "api-1") || strings.Contains(out, "web-1") {
+		t.Errorf("isim filtresi liste görünümüne uygulanmalı:\n%s", out)
+	}
+}
+
+func TestPodSelectorsSkipClusterScopedKinds(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	s := testutil.NewSession(node, ns, pod("default", "web-1"))
+	// Gerçek API sunucusu gibi desteklenmeyen field selector'ı reddet
+	for _, resource := range []string{"nodes", "namespaces"} {
+		s.Client.(*fake.Clientset).PrependReactor("list", resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+			restrictions := action.(k8stesting.ListAction).GetListRestrictions()
+			if !restrictions.Fields.Empty() || !restrictions.Labels.Empty() {
+				return true, nil, apierrors.NewBadRequest(`field label not supported: status.phase`)
+			}
+			return false, nil, nil
+		})
+	}
+	s.Settings.Filter = session.Filter{LabelSelector: "app=web", FieldSelector: "status.phase=Running", FieldSelectorKind: "pods"}
+
+	nodes, err := Nodes(s, metav1.ListOptions{})
+	if err != nil || len(nodes.Items) != 1 {
+		t.Errorf("pod filtresi node listesini etkilememeli: %v %v", nodes, err)
+	}
+	namespaces, err := Namespaces(s, metav1.ListOptions{})
+	if err != nil || len(namespaces.Items) != 1 {
+		t.Errorf("pod filtresi namespace listesini etkilememeli: %v %v", namespaces, err)
+	}
+}
+
+func podNames(pods []corev1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, p := range pods {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestSelectFilters(t *testing.T) {
+	s := testutil.NewSession()
+
+	testutil.RunWithInput(t, "2\napp=web\n4\n/^api/\n1\nclient-access\n6\n", func() { selectFilters(s) })
+	want := session.Filter{LabelSelector: "app=web", Name: "/^api/"}
+	if s.Settings.Filter != want {
+		t.Errorf("filtre = %+v, beklenen %+v", s.Settings.Filter, want)
+	}
+	if s.Namespace != "client-access" {
+		t.Errorf("namespace ayarlanmalıydı: %q", s.Namespace)
+	}
+
+	out := testutil.RunWithInput(t, "3\nstatus.phase\n6\n", func() { selectFilters(s) })
+	if !strings.Contains(out, "geçersiz field selector") || s.Settings.Filter != want {
+		t.Errorf("geçersiz selector reddedilmeli (%+v):\n%s", s.Settings.Filter, out)
+	}
+
+	testutil.RunWithInput(t, "3\nstatus.phase=Running\n\n6\n", func() { selectFilters(s) })
+	if f := s.Settings.Filter; f.FieldSelector != "status.phase=Running" || f.FieldSelectorKind != "pods" {
+		t.Errorf("field selector varsayılan olarak podlara uygulanmalı: %+v", f)
+	}
+
+	testutil.RunWithInput(t, "5\n6\n", func() { selectFilters(s) })
+	if !s.Settings.Filter.IsEmpty() {
+		t.Errorf("filtreler temizlenmeliydi: %+v", s.Settings.Filter)
+	}
+}
+
+// pagedPods podları istekteki Limit/Continue değerlerine göre sayfalayan bir
+// reactor ekler; continue token'ı sonraki sayfanın başladığı sıradır. Yapılan
+// istek sayısını gösteren bir sayaç döndürür.
+func pagedPods(s *session.Session, count int) *int {
+	requests := 0
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		requests++
+		opts := action.(k8stesting.ListActionImpl).ListOptions
+		start, _ := strconv.Atoi(opts.Continue)
+		end := count
+		if opts.Limit > 0 && start+int(opts.Limit) < count {
+			end = start + int(opts.Limit)
+		}
+		list := &corev1.PodList{}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, *pod("default", fmt.Sprintf("pod-%d", i+1)))
+		}
+		if end < count {
+			list.Continue = strconv.Itoa(end)
+		}
+		return true, list, nil
+	})
+	return &requests
+}
+
+func TestPagerNavigation(t *testing.T) {
+	s := testutil.NewSession()
+	s.Settings.PageSize = 2
+	pagedPods(s, 5)
+	pager := NewPager(s, Pods, metav1.ListOptions{})
+
+	expect := func(list *corev1.PodList, err error, number int, names ...string) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := podNames(list.Items); strings.Join(got, ",") != strings.Join(names, ",") {
+			t.Errorf("sayfa %d: %v, beklenen %v", pager.Number(), got, names)
+		}
+		if pager.Number() != number {
+			t.Errorf("sayfa numarası %d, beklenen %d", pager.Number(), number)
+		}
+	}
+
+	list, err := pager.First()
+	expect(list, err, 1, "pod-1", "pod-2")
+	if pager.HasPrev() || !pager.HasNext() {
+		t.Error("ilk sayfada yalnızca sonraki sayfa olmalı")
+	}
+	list, err = pager.Next()
+	expect(list, err, 2, "pod-3", "pod-4")
+	list, err = pager.Next()
+	expect(list, err, 3, "pod-5")
+	if pager.HasNext() {
+		t.Error("son sayfada sonraki sayfa olmamalı")
+	}
+	list, err = pager.Prev()
+	expect(list, err, 2, "pod-3", "pod-4")
+	list, err = pager.Prev()
+	expect(list, err, 1, "pod-1", "pod-2")
+}
+
+func TestPagerRestartsOnExpiredToken(t *testing.T) {
+	s := testutil.NewSession()
+	s.Settings.PageSize = 2
+	pagedPods(s, 5)
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.ListActionImpl).ListOptions.Continue != "" {
+			return true, nil, apierrors.NewResourceExpired("continue token süresi doldu")
+		}
+		return false, nil, nil
+	})
+	pager := NewPager(s, Pods, metav1.ListOptions{})
+
+	if _, err := pager.First(); err != nil {
+		t.Fatal(err)
+	}
+	list, err := pager.Next()
+	if err != nil {
+		t.Fatalf("süresi dolan token baştan listelenmeliydi: %v", err)
+	}
+	if pager.Number() != 1 || len(list.Items) != 2 || list.Items[0].Name != "pod-1" {
+		t.Errorf("ilk sayfaya dönülmeliydi: sayfa %d, %v", pager.Number(), podNames(list.Items))
+	}
+}
+
+func TestCollectMergesPages(t *testing.T) {
+	s := testutil.NewSession()
+	requests := pagedPods(s, 1200)
+
+	list, err := Collect(s, Pods, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1200 || list.Items[1199].Name != "pod-1200" {
+		t.Errorf("tüm sayfalar birleştirilmeliydi: %d öğe", len(list.Items))
+	}
+	if *requests != 3 || list.Continue != "" {
+		t.Errorf("%d istek yapıldı (3 beklendi), continue=%q", *requests, list.Continue)
+	}
+}
+
+func TestListPodsPages(t *testing.T) {
+	s := testutil.NewSession()
+	s.Settings.PageSize = 2
+	pagedPods(s, 3)
+
+	out := testutil.RunWithInput(t, "n\n0\n", func() { ListPods(s) })
+	for _, want := range []string{"pod-1", "Sayfa 1 | n: sonraki sayfa", "pod-3", "Sayfa 2 | p: önceki sayfa"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("çıktıda %q bulunamadı:\n%s", want, out)
+		}
+	}
+}
+
+func TestListConfigMapsSinglePageDoesNotPrompt(t *testing.T) {
+	s := testutil.NewSession(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"}})
+	s.Settings.PageSize = 2
+
+	out := testutil.RunWithInput(t, "", func() { listConfigMaps(s) })
+	if !strings.Contains(out, "settings") || strings.Contains(out, "Sayfa") {
+		t.Errorf("tek sayfalık listede sayfa sorusu olmamalı:\n%s", out)
+	}
+}
+
+// podListCalls fake client'a yapılan pod listeleme isteklerini sayar
+func podListCalls(s *session.Session) int {
+	calls := 0
+	for _, action := range s.Client.(*fake.Clientset).Actions() {
+		if action.Matches("list", "pods") {
+			calls++
+		}
+	}
+	return calls
+}
+
+func TestListNamespacesListsPodsOnce(t *testing.T) {
+	objects := []runtime.Object{}
+	for _, name := range []string{"a", "b", "c", "d"} {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, pod(name, "p-"+name))
+	}
+	s := testutil.NewSession(objects...)
+
+	testutil.RunWithInput(t, "", func() { listNamespaces(s) })
+	if calls := podListCalls(s); calls != 1 {
+		t.Errorf("podlar tek istekte listelenmeli, %d istek yapıldı", calls)
+	}
+}
+
+func TestListNodesCountsPods(t *testing.T) {
+	other := pod("default", "c")
+	other.Spec.NodeName = "node-2"
+	s := testutil.NewSession(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+		pod("default", "a"), pod("kube-system", "b"), other,
+	)
+
+	out := testutil.RunWithInput(t, "", func() { listNodes(s) })
+	if podCountIn(out, "node-1", 5) != "2" || podCountIn(out, "node-2", 5) != "1" {
+		t.Errorf("node başına pod sayıları yanlış:\n%s", out)
+	}
+	if calls := podListCalls(s); calls != 1 {
+		t.Errorf("podlar tek istekte listelenmeli, %d istek yapıldı", calls)
+	}
+}
+
+func TestCollectRestartsOnExpiredToken(t *testing.T) {
+	s := testutil.NewSession()
+	pagedPods(s, 1200)
+	expired := false
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.ListActionImpl).ListOptions.Continue == "1000" && !expired {
+			expired = true
+			return true, nil, apierrors.NewResourceExpired("continue token süresi doldu")
+		}
+		return false, nil, nil
+	})
+
+	list, err := Collect(s, Pods, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, p := range list.Items {
+		if seen[p.Name] {
+			t.Fatalf("%s birden fazla kez listelendi", p.Name)
+		}
+		seen[p.Name] = true
+	}
+	if len(list.Items) != 1200 {
+		t.Errorf("%d öğe listelendi, 1200 beklendi", len(list.Items))
+	}
+}
+
+func TestPodCountsRestartsOnExpiredToken(t *testing.T) {
+	s := testutil.NewSession()
+	pagedPods(s, 1200)
+	expired := false
+	s.Client.(*fake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.ListActionImpl).ListOptions.Continue == "1000" && !expired {
+			expired = true
+			return true, nil, apierrors.NewResourceExpired("continue token süresi doldu")
+		}
+		return false, nil, nil
+	})
+
+	counts, err := PodCounts(s, podNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["default"] != 1200 {
+		t.Errorf("pod sayısı %d, 1200 beklendi", counts["default"])
+	}
+}
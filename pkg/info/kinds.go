@@ -0,0 +1,138 @@
+package info
+
+import (
+	"strings"
+
+	"tamerGoClient/pkg/output"
+	"tamerGoClient/pkg/session"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Kind komut satırı ve tam ekran arayüzün listeleyebildiği bir kaynak türü
+type Kind struct {
+	Name       string   // Çoğul isim (pods)
+	Aliases    []string // Tekil isim ve kısaltmalar; ilki tekil isimdir
+	Namespaced bool
+	Columns    []output.Column // NAMESPACE sütunu hariç
+	List       func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error)
+}
+
+// Singular türün tekil ismini döndürür (resource.Delete bu isimleri kullanır)
+func (k Kind) Singular() string {
+	return k.Aliases[0]
+}
+
+// Kinds bilinen kaynak türleri, menüdeki sırayla
+var Kinds = []Kind{
+	{
+		Name: "namespaces", Aliases: []string{"namespace", "ns"},
+		Columns: NamespaceColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Namespaces(s, opts)
+		},
+	},
+	{
+		Name: "nodes", Aliases: []string{"node", "no"},
+		Columns: NodeColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Nodes(s, opts)
+		},
+	},
+	{
+		Name: "pods", Aliases: []string{"pod", "po"}, Namespaced: true,
+		Columns: PodColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Pods(s, opts)
+		},
+	},
+	{
+		Name: "deployments", Aliases: []string{"deployment", "deploy"}, Namespaced: true,
+		Columns: DeploymentColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Deployments(s, opts)
+		},
+	},
+	{
+		Name: "services", Aliases: []string{"service", "svc"}, Namespaced: true,
+		Columns: ServiceColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Services(s, opts)
+		},
+	},
+	{
+		Name: "configmaps", Aliases: []string{"configmap", "cm"}, Namespaced: true,
+		Columns: ConfigMapColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return ConfigMaps(s, opts)
+		},
+	},
+	{
+		Name: "secrets", Aliases: []string{"secret"}, Namespaced: true,
+		Columns: SecretColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Secrets(s, opts)
+		},
+	},
+	{
+		Name: "persistentvolumes", Aliases: []string{"persistentvolume", "pv"},
+		Columns: PersistentVolumeColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return PersistentVolumes(s, opts)
+		},
+	},
+	{
+		Name: "persistentvolumeclaims", Aliases: []string{"persistentvolumeclaim", "pvc"}, Namespaced: true,
+		Columns: PersistentVolumeClaimColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return PersistentVolumeClaims(s, opts)
+		},
+	},
+	{
+		Name: "statefulsets", Aliases: []string{"statefulset", "sts"}, Namespaced: true,
+		Columns: StatefulSetColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return StatefulSets(s, opts)
+		},
+	},
+	{
+		Name: "daemonsets", Aliases: []string{"daemonset", "ds"}, Namespaced: true,
+		Columns: DaemonSetColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return DaemonSets(s, opts)
+		},
+	},
+	{
+		Name: "ingresses", Aliases: []string{"ingress", "ing"}, Namespaced: true,
+		Columns: IngressColumns,
+		List: func(s *session.Session, opts metav1.ListOptions) (runtime.Object, error) {
+			return Ingresses(s, opts)
+		},
+	},
+}
+
+// FindKind tür adını (çoğul, tekil veya kısaltma) kayıtlı türlerde arar
+func FindKind(name string) (Kind, bool) {
+	name = strings.ToLower(name)
+	for _, kind := range Kinds {
+		if kind.Name == name {
+			return kind, true
+		}
+		for _, alias := range kind.Aliases {
+			if alias == name {
+				return kind, true
+			}
+		}
+	}
+	return Kind{}, false
+}
+
+// KindNames yardım ve hata mesajları için desteklenen türleri listeler
+func KindNames() string {
+	names := make([]string, 0, len(Kinds))
+	for _, kind := range Kinds {
+		names = append(names, kind.Name)
+	}
+	return strings.Join(names, ", ")
+}
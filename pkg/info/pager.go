@@ -0,0 +1,198 @@
+package info
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// collectChunkSize tüm sayfaları toplayan listelemelerde istek başına
+// alınacak öğe sayısı
+const collectChunkSize = 500
+
+// Pager bir listeleme fonksiyonunu Limit/Continue ile sayfa sayfa çalıştırır.
+// Bellekte yalnızca geçerli sayfa ile önceki sayfalara dönebilmek için gereken
+// continue token'ları tutulur; her sayfa isteği kendi zaman aşımıyla yapılır.
+type Pager[T runtime.Object] struct {
+	s      *session.Session
+	fetch  func(*session.Session, metav1.ListOptions) (T, error)
+	opts   metav1.ListOptions
+	tokens []string // tokens[i], i. sayfayı getiren continue token'ı; ilk sayfanınki boştur
+	next   string   // Sonraki sayfanın continue token'ı, son sayfada boş
+}
+
+// NewPager oturumun sayfa boyutuyla çalışan bir Pager döndürür. fetch bu
+// paketteki listeleme fonksiyonlarından biri olabilir (örn. Pods).
+func NewPager[T runtime.Object](s *session.Session, fetch func(*session.Session, metav1.ListOptions) (T, error), opts metav1.ListOptions) *Pager[T] {
+	opts.Limit = s.Settings.PageSize
+	return &Pager[T]{s: s, fetch: fetch, opts: opts}
+}
+
+// First ilk sayfayı getirir
+func (p *Pager[T]) First() (T, error) {
+	p.tokens = p.tokens[:0]
+	return p.load("")
+}
+
+// Next sonraki sayfayı getirir; HasNext false iken çağrılmamalıdır
+func (p *Pager[T]) Next() (T, error) {
+	return p.load(p.next)
+}
+
+// Prev önceki sayfayı yeniden getirir; HasPrev false iken çağrılmamalıdır
+func (p *Pager[T]) Prev() (T, error) {
+	tokens, next := p.tokens, p.next
+	previous := p.tokens[len(p.tokens)-2]
+	p.tokens = p.tokens[:len(p.tokens)-2]
+	list, err := p.load(previous)
+	if err != nil {
+		p.tokens, p.next = tokens, next
+	}
+	return list, err
+}
+
+// HasNext sunucuda alınmamış sayfa kalıp kalmadığını döndürür
+func (p *Pager[T]) HasNext() bool {
+	return p.next != ""
+}
+
+// HasPrev geçerli sayfadan önce sayfa olup olmadığını döndürür
+func (p *Pager[T]) HasPrev() bool {
+	return len(p.tokens) > 1
+}
+
+// Number geçerli sayfanın 1'den başlayan sırasını döndürür
+func (p *Pager[T]) Number() int {
+	return len(p.tokens)
+}
+
+func (p *Pager[T]) load(token string) (T, error) {
+	opts := p.opts
+	opts.Continue = token
+	list, err := p.fetch(p.s, opts)
+	if token != "" && apierrors.IsResourceExpired(err) {
+		// Continue token'ları sunucuda birkaç dakika geçerlidir; süresi
+		// dolduysa liste güncel haliyle baştan alınır
+		fmt.Fprintln(os.Stderr, i18n.T("Uyarı: Sayfalama bilgisinin süresi doldu, liste baştan alınıyor."))
+		p.tokens = p.tokens[:0]
+		token, opts.Continue = "", ""
+		list, err = p.fetch(p.s, opts)
+	}
+	if err != nil {
+		return list, err
+	}
+
+	p.tokens = append(p.tokens, token)
+	p.next = ""
+	if accessor, err := meta.ListAccessor(list); err == nil {
+		p.next = accessor.GetContinue()
+	}
+	return list, nil
+}
+
+// Collect tüm sayfaları sırayla alıp tek bir listede birleştirir. Her istek
+// kendi zaman aşımıyla yapıldığından büyük listeler tek istekte zaman aşımına
+// uğramaz; tüm sonuçların aynı anda gerektiği komut satırı çıktısı ve tam
+// ekran arayüz bunu kullanır.
+func Collect[T runtime.Object](s *session.Session, fetch func(*session.Session, metav1.ListOptions) (T, error), opts metav1.ListOptions) (T, error) {
+	pager := &Pager[T]{s: s, fetch: fetch, opts: opts}
+	pager.opts.Limit = collectChunkSize
+
+	list, err := pager.First()
+	if err != nil || !pager.HasNext() {
+		return list, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return list, err
+	}
+	for pager.HasNext() {
+		page, err := pager.Next()
+		if err != nil {
+			return list, err
+		}
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return list, err
+		}
+		if pager.Number() == 1 {
+			// Continue token'ının süresi dolduğu için liste baştan alındı;
+			// önceki sayfalar tekrar geleceğinden toplananlar atılır
+			list, items = page, nil
+		}
+		items = append(items, pageItems...)
+	}
+	if accessor, err := meta.ListAccessor(list); err == nil {
+		accessor.SetContinue("")
+	}
+	return list, meta.SetList(list, items)
+}
+
+// Browse listeyi sayfa sayfa gösterir. show geçerli sayfayı yazar ve
+// satırların numarayla seçilebilir olup olmadığını döndürür. prompt boş
+// değilse ve satırlar seçilebiliyorsa kullanıcıdan bir satır numarası istenir;
+// seçilen satırın bulunduğu sayfa ve sayfadaki sırası döner. Seçim
+// yapılmadıysa index -1'dir. Debug modunda her sayfanın API çağrısı sayısı ve
+// süresi yazılır.
+func Browse[T runtime.Object](p *Pager[T], prompt string, show func(page T) bool) (page T, index int, err error) {
+	measure := p.s.Measure()
+	page, err = p.First()
+	for err == nil {
+		selectable := show(page) && prompt != ""
+		measure(i18n.Sprintf("sayfa %d", p.Number()))
+		paged := p.HasNext() || p.HasPrev()
+		if !paged && !selectable {
+			return page, -1, nil
+		}
+
+		if paged {
+			i18n.Printf("\nSayfa %d", p.Number())
+			if p.HasPrev() {
+				fmt.Print(i18n.T(" | p: önceki sayfa"))
+			}
+			if p.HasNext() {
+				fmt.Print(i18n.T(" | n: sonraki sayfa"))
+			}
+			fmt.Println()
+		}
+		if selectable {
+			fmt.Print(prompt)
+		} else {
+			fmt.Print(i18n.T("Sayfa değiştirmek için n/p, çıkmak için Enter: "))
+		}
+
+		input := strings.ToLower(readLine())
+		measure = p.s.Measure()
+		switch {
+		case input == "n" && p.HasNext():
+			page, err = p.Next()
+		case input == "p" && p.HasPrev():
+			page, err = p.Prev()
+		default:
+			choice, convErr := strconv.Atoi(input)
+			if selectable && convErr == nil && choice > 0 && choice <= meta.LenList(page) {
+				return page, choice - 1, nil
+			}
+			return page, -1, nil
+		}
+	}
+	return page, -1, err
+}
+
+// browsePages satır seçimi gerektirmeyen listeleri sayfa sayfa gösterir
+func browsePages[T runtime.Object](p *Pager[T], show func(page T)) error {
+	_, _, err := Browse(p, "", func(page T) bool {
+		show(page)
+		return false
+	})
+	return err
+}
@@ -0,0 +1,166 @@
+package info
+
+import (
+	"tamerGoClient/pkg/session"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Bu dosyadaki fonksiyonlar kullanıcıyla etkileşime girmeden kaynakları
+// listeler; hem menüler hem de komut satırı arayüzü tarafından kullanılır.
+// Namespace'li kaynaklar oturumun namespace'inde listelenir ve cluster
+// genelinde yetki yoksa yedek namespace'e düşülür. Oturumun filtreleri
+// (session.Filter) her türe, o türe uygulanabilen kısmıyla uygulanır.
+
+// Namespaces cluster'daki namespace'leri döndürür
+func Namespaces(s *session.Session, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("namespaces", false, opts)
+	list, err := s.Client.CoreV1().Namespaces().List(ctx, opts)
+	return filterByName(s, list, err)
+}
+
+// Nodes cluster'daki node'ları döndürür
+func Nodes(s *session.Session, opts metav1.ListOptions) (*corev1.NodeList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("nodes", false, opts)
+	list, err := s.Client.CoreV1().Nodes().List(ctx, opts)
+	return filterByName(s, list, err)
+}
+
+// PersistentVolumes cluster'daki PersistentVolume'ları döndürür
+func PersistentVolumes(s *session.Session, opts metav1.ListOptions) (*corev1.PersistentVolumeList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("persistentvolumes", false, opts)
+	list, err := s.Client.CoreV1().PersistentVolumes().List(ctx, opts)
+	return filterByName(s, list, err)
+}
+
+// Pods oturumun namespace'indeki podları döndürür
+func Pods(s *session.Session, opts metav1.ListOptions) (*corev1.PodList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("pods", true, opts)
+	list, err := session.List(s, func(namespace string) (*corev1.PodList, error) {
+		return s.Client.CoreV1().Pods(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// Services oturumun namespace'indeki service'leri döndürür
+func Services(s *session.Session, opts metav1.ListOptions) (*corev1.ServiceList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("services", true, opts)
+	list, err := session.List(s, func(namespace string) (*corev1.ServiceList, error) {
+		return s.Client.CoreV1().Services(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// Deployments oturumun namespace'indeki deployment'ları döndürür
+func Deployments(s *session.Session, opts metav1.ListOptions) (*appsv1.DeploymentList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("deployments", true, opts)
+	list, err := session.List(s, func(namespace string) (*appsv1.DeploymentList, error) {
+		return s.Client.AppsV1().Deployments(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// ConfigMaps oturumun namespace'indeki ConfigMap'leri döndürür
+func ConfigMaps(s *session.Session, opts metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("configmaps", true, opts)
+	list, err := session.List(s, func(namespace string) (*corev1.ConfigMapList, error) {
+		return s.Client.CoreV1().ConfigMaps(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// Secrets oturumun namespace'indeki secret'ları döndürür
+func Secrets(s *session.Session, opts metav1.ListOptions) (*corev1.SecretList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("secrets", true, opts)
+	list, err := session.List(s, func(namespace string) (*corev1.SecretList, error) {
+		return s.Client.CoreV1().Secrets(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// PersistentVolumeClaims oturumun namespace'indeki PVC'leri döndürür
+func PersistentVolumeClaims(s *session.Session, opts metav1.ListOptions) (*corev1.PersistentVolumeClaimList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("persistentvolumeclaims", true, opts)
+	list, err := session.List(s, func(namespace string) (*corev1.PersistentVolumeClaimList, error) {
+		return s.Client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// StatefulSets oturumun namespace'indeki StatefulSet'leri döndürür
+func StatefulSets(s *session.Session, opts metav1.ListOptions) (*appsv1.StatefulSetList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("statefulsets", true, opts)
+	list, err := session.List(s, func(namespace string) (*appsv1.StatefulSetList, error) {
+		return s.Client.AppsV1().StatefulSets(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// DaemonSets oturumun namespace'indeki DaemonSet'leri döndürür
+func DaemonSets(s *session.Session, opts metav1.ListOptions) (*appsv1.DaemonSetList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("daemonsets", true, opts)
+	list, err := session.List(s, func(namespace string) (*appsv1.DaemonSetList, error) {
+		return s.Client.AppsV1().DaemonSets(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// Ingresses oturumun namespace'indeki ingress'leri döndürür
+func Ingresses(s *session.Session, opts metav1.ListOptions) (*networkingv1.IngressList, error) {
+	ctx, cancel := s.Context()
+	defer cancel()
+	opts = s.Settings.Filter.Apply("ingresses", true, opts)
+	list, err := session.List(s, func(namespace string) (*networkingv1.IngressList, error) {
+		return s.Client.NetworkingV1().Ingresses(namespace).List(ctx, opts)
+	})
+	return filterByName(s, list, err)
+}
+
+// filterByName oturumun isim filtresine uymayan öğeleri listeden çıkarır
+func filterByName[T runtime.Object](s *session.Session, list T, err error) (T, error) {
+	if err != nil || s.Settings.Filter.Name == "" {
+		return list, err
+	}
+	match, err := s.Settings.Filter.NameMatcher()
+	if err != nil {
+		return list, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return list, err
+	}
+	kept := items[:0]
+	for _, item := range items {
+		if accessor, err := meta.Accessor(item); err == nil && match(accessor.GetName()) {
+			kept = append(kept, item)
+		}
+	}
+	return list, meta.SetList(list, kept)
+}
@@ -0,0 +1,364 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"tamerGoClient/pkg/i18n"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// Desteklenen çıktı biçimleri. custom-columns ve jsonpath biçimleri
+// "=" ile ayrılmış bir şablon alır.
+const (
+	Table         = "table"
+	Wide          = "wide"
+	JSON          = "json"
+	YAML          = "yaml"
+	CSV           = "csv"
+	CustomColumns = "custom-columns"
+	JSONPath      = "jsonpath"
+)
+
+// Formats yardım ve hata mesajlarında gösterilen biçim listesi
+const Formats = "table, wide, json, yaml, csv, custom-columns=<BAŞLIK>:<.alan>,..., jsonpath=<şablon>"
+
+// Değeri olmayan veya bilinmeyen hücreler için sütunların döndürdüğü sabit
+// metinler. Tabloda etkin dile çevrilirler (bkz. Cell); CSV ve custom-columns
+// gibi makine tarafından okunan çıktılarda dile bağlı olmamaları için olduğu
+// gibi yazılırlar.
+const (
+	None    = "<yok>"
+	Unknown = "<bilinmiyor>"
+)
+
+// Cell hücre değerini ekranda gösterileceği hale getirir
+func Cell(value string) string {
+	switch value {
+	case None:
+		return i18n.T("<yok>")
+	case Unknown:
+		return i18n.T("<bilinmiyor>")
+	}
+	return value
+}
+
+// Column tablo ve CSV çıktılarındaki bir sütun. Wide sütunlar yalnızca
+// wide ve csv biçimlerinde gösterilir. Header Türkçe yazılır; tabloda etkin
+// dile çevrilir, CSV'de dilden bağımsız olması için olduğu gibi kalır.
+type Column struct {
+	Header string
+	Wide   bool
+	Value  func(obj runtime.Object) string
+}
+
+// NamespaceColumn tüm namespace'ler listelenirken tablonun başına eklenen sütun
+var NamespaceColumn = Column{Header: "NAMESPACE", Value: func(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetNamespace()
+}}
+
+// Printer bir liste nesnesini (PodList, ServiceList, ...) belirli bir biçimde yazar.
+// Sütunlar yalnızca tablo tabanlı biçimler tarafından kullanılır.
+type Printer interface {
+	Print(w io.Writer, list runtime.Object, columns []Column) error
+}
+
+// IsTable biçimin menülerin klasik tablo görünümü olup olmadığını döndürür
+func IsTable(format string) bool {
+	return format == "" || format == Table
+}
+
+// NewPrinter "json", "wide" veya "jsonpath={.items[*].metadata.name}" gibi
+// bir biçim tanımından yazıcı oluşturur. Boş tanım tablo anlamına gelir.
+func NewPrinter(format string) (Printer, error) {
+	name, template, hasTemplate := strings.Cut(format, "=")
+	switch {
+	case IsTable(format):
+		return &tablePrinter{}, nil
+	case format == Wide:
+		return &tablePrinter{wide: true}, nil
+	case format == JSON:
+		return &jsonPrinter{}, nil
+	case format == YAML:
+		return &yamlPrinter{}, nil
+	case format == CSV:
+		return &csvPrinter{}, nil
+	case name == CustomColumns && hasTemplate:
+		return newCustomColumnsPrinter(template)
+	case name == JSONPath && hasTemplate:
+		return newJSONPathPrinter(template)
+	}
+	return nil, i18n.Errorf("desteklenmeyen çıktı biçimi: %s (desteklenenler: %s)", format, i18n.T(Formats))
+}
+
+// Print biçim tanımını ayrıştırıp listeyi yazar
+func Print(w io.Writer, format string, list runtime.Object, columns []Column) error {
+	printer, err := NewPrinter(format)
+	if err != nil {
+		return err
+	}
+	return printer.Print(w, list, columns)
+}
+
+// visibleColumns wide değilse yalnızca temel sütunları döndürür
+func visibleColumns(columns []Column, wide bool) []Column {
+	if wide {
+		return columns
+	}
+	visible := make([]Column, 0, len(columns))
+	for _, column := range columns {
+		if !column.Wide {
+			visible = append(visible, column)
+		}
+	}
+	return visible
+}
+
+// rows listedeki her nesne için sütun değerlerini hesaplar
+func rows(list runtime.Object, columns []Column) ([][]string, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = column.Value(item)
+		}
+		result = append(result, row)
+	}
+	return result, nil
+}
+
+func headers(columns []Column) []string {
+	result := make([]string, len(columns))
+	for i, column := range columns {
+		result[i] = column.Header
+	}
+	return result
+}
+
+type tablePrinter struct {
+	wide bool
+}
+
+func (p *tablePrinter) Print(w io.Writer, list runtime.Object, columns []Column) error {
+	columns = visibleColumns(columns, p.wide)
+	values, err := rows(list, columns)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	header := headers(columns)
+	for i := range header {
+		header[i] = i18n.T(header[i])
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range values {
+		for i := range row {
+			row[i] = Cell(row[i])
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// csvPrinter wide sütunlar dahil tüm sütunları başlık satırıyla yazar
+type csvPrinter struct{}
+
+func (p *csvPrinter) Print(w io.Writer, list runtime.Object, columns []Column) error {
+	values, err := rows(list, columns)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	cw.Write(headers(columns))
+	cw.WriteAll(values)
+	return cw.Error()
+}
+
+type jsonPrinter struct{}
+
+func (p *jsonPrinter) Print(w io.Writer, list runtime.Object, _ []Column) error {
+	data, err := toUnstructuredList(list)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(data, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(encoded))
+	return err
+}
+
+type yamlPrinter struct{}
+
+func (p *yamlPrinter) Print(w io.Writer, list runtime.Object, _ []Column) error {
+	data, err := toUnstructuredList(list)
+	if err != nil {
+		return err
+	}
+	encoded, err := yaml.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(encoded)
+	return err
+}
+
+// jsonPathPrinter şablonu kubectl'deki gibi liste nesnesinin tamamına uygular
+type jsonPathPrinter struct {
+	parser *jsonpath.JSONPath
+}
+
+func newJSONPathPrinter(template string) (*jsonPathPrinter, error) {
+	parser := jsonpath.New("output").AllowMissingKeys(true)
+	if err := parser.Parse(relaxedJSONPath(template)); err != nil {
+		return nil, i18n.Errorf("geçersiz jsonpath şablonu: %v", err)
+	}
+	return &jsonPathPrinter{parser: parser}, nil
+}
+
+func (p *jsonPathPrinter) Print(w io.Writer, list runtime.Object, _ []Column) error {
+	data, err := toUnstructuredList(list)
+	if err != nil {
+		return err
+	}
+	if err := p.parser.Execute(w, data); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+// customColumnsPrinter "BAŞLIK:.alan" çiftlerinden oluşan sütunları tablo olarak yazar
+type customColumnsPrinter struct {
+	headers []string
+	parsers []*jsonpath.JSONPath
+}
+
+func newCustomColumnsPrinter(spec string) (*customColumnsPrinter, error) {
+	p := &customColumnsPrinter{}
+	for _, part := range strings.Split(spec, ",") {
+		header, path, found := strings.Cut(part, ":")
+		if !found || header == "" || path == "" {
+			return nil, i18n.Errorf("geçersiz custom-columns tanımı: %q (BAŞLIK:.alan bekleniyordu)", part)
+		}
+		parser := jsonpath.New(header).AllowMissingKeys(true)
+		if err := parser.Parse(relaxedJSONPath(path)); err != nil {
+			return nil, i18n.Errorf("%s sütunu için geçersiz alan: %v", header, err)
+		}
+		p.headers = append(p.headers, header)
+		p.parsers = append(p.parsers, parser)
+	}
+	return p, nil
+}
+
+func (p *customColumnsPrinter) Print(w io.Writer, list runtime.Object, _ []Column) error {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(p.headers, "\t"))
+	for _, item := range items {
+		data, err := toUnstructured(item)
+		if err != nil {
+			return err
+		}
+		cells := make([]string, len(p.parsers))
+		for i, parser := range p.parsers {
+			results, err := parser.FindResults(data)
+			if err != nil {
+				return err
+			}
+			var values []string
+			for _, result := range results {
+				for _, value := range result {
+					values = append(values, fmt.Sprint(value.Interface()))
+				}
+			}
+			cells[i] = strings.Join(values, ",")
+			if cells[i] == "" {
+				cells[i] = None
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// relaxedJSONPath ".metadata.name" gibi süslü parantezsiz ifadeleri kabul eder
+func relaxedJSONPath(path string) string {
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "[") {
+		path = "." + path
+	}
+	return "{" + path + "}"
+}
+
+// toUnstructured nesneyi apiVersion/kind alanları doldurulmuş bir map'e çevirir.
+// Typed client'ların döndürdüğü liste elemanlarında bu alanlar boştur.
+func toUnstructured(obj runtime.Object) (map[string]interface{}, error) {
+	if kinds, _, err := scheme.Scheme.ObjectKinds(obj); err == nil && len(kinds) > 0 {
+		obj = obj.DeepCopyObject()
+		obj.GetObjectKind().SetGroupVersionKind(kinds[0])
+	}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	// kubectl'deki gibi okunabilirlik için managedFields gösterilmez
+	if metadata, ok := data["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "managedFields")
+	}
+	return data, nil
+}
+
+// MarshalYAML tek bir nesneyi apiVersion/kind alanlarıyla birlikte YAML'a çevirir
+func MarshalYAML(obj runtime.Object) ([]byte, error) {
+	data, err := toUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(data)
+}
+
+// toUnstructuredList listeyi kubectl'in çıktısındaki gibi "kind: List" nesnesine çevirir
+func toUnstructuredList(list runtime.Object) (map[string]interface{}, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	converted := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		data, err := toUnstructured(item)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, data)
+	}
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"metadata":   map[string]interface{}{"resourceVersion": ""},
+		"items":      converted,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"tamerGoClient/internal/testutil"
+	"tamerGoClient/pkg/i18n"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+func podList() *corev1.PodList {
+	return &corev1.PodList{Items: []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-1"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db, primary", Namespace: "data"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	}}
+}
+
+var columns = []Column{
+	{Header: "İSİM", Value: func(obj runtime.Object) string { return obj.(*corev1.Pod).Name }},
+	{Header: "DURUM", Value: func(obj runtime.Object) string { return string(obj.(*corev1.Pod).Status.Phase) }},
+	{Header: "NODE", Wide: true, Value: func(obj runtime.Object) string { return obj.(*corev1.Pod).Spec.NodeName }},
+}
+
+func render(t *testing.T, format string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Print(&buf, format, podList(), columns); err != nil {
+		t.Fatalf("%s: %v", format, err)
+	}
+	return buf.String()
+}
+
+func TestTableAndWide(t *testing.T) {
+	table := render(t, "")
+	if !strings.Contains(table, "İSİM") || !strings.Contains(table, "Running") {
+		t.Errorf("tablo çıktısı beklenen biçimde değil:\n%s", table)
+	}
+	if strings.Contains(table, "NODE") {
+		t.Errorf("wide sütun tabloda gösterilmemeli:\n%s", table)
+	}
+	if wide := render(t, Wide); !strings.Contains(wide, "NODE") || !strings.Contains(wide, "node-1") {
+		t.Errorf("wide çıktıda ek sütun bekleniyordu:\n%s", wide)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var list struct {
+		Kind  string `json:"kind"`
+		Items []struct {
+			APIVersion string            `json:"apiVersion"`
+			Kind       string            `json:"kind"`
+			Metadata   metav1.ObjectMeta `json:"metadata"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(render(t, JSON)), &list); err != nil {
+		t.Fatalf("geçerli JSON bekleniyordu: %v", err)
+	}
+	if list.Kind != "List" || len(list.Items) != 2 {
+		t.Fatalf("iki elemanlı List bekleniyordu: %+v", list)
+	}
+	if item := list.Items[0]; item.APIVersion != "v1" || item.Kind != "Pod" || item.Metadata.Name != "web" {
+		t.Errorf("apiVersion/kind doldurulmalıydı: %+v", item)
+	}
+}
+
+func TestYAML(t *testing.T) {
+	var list map[string]interface{}
+	if err := yaml.Unmarshal([]byte(render(t, YAML)), &list); err != nil {
+		t.Fatalf("geçerli YAML bekleniyordu: %v", err)
+	}
+	if items, _ := list["items"].([]interface{}); len(items) != 2 {
+		t.Errorf("iki eleman bekleniyordu: %v", list)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	want := "İSİM,DURUM,NODE\nweb,Running,node-1\n\"db, primary\",Pending,\n"
+	if got := render(t, CSV); got != want {
+		t.Errorf("CSV çıktısı:\n%s\nbeklenen:\n%s", got, want)
+	}
+}
+
+// Makine tarafından okunan çıktılar arayüz dilinden etkilenmemeli; tablo ise çevrilir
+func TestMachineReadableOutputIgnoresLanguage(t *testing.T) {
+	i18n.SetLanguage(i18n.English)
+	defer i18n.SetLanguage(i18n.Turkish)
+
+	if got := render(t, CSV); !strings.HasPrefix(got, "İSİM,DURUM,NODE\n") {
+		t.Errorf("CSV başlığı çevrilmemeli:\n%s", got)
+	}
+	if got := render(t, "custom-columns=NODE:.spec.nodeName"); !strings.Contains(got, None) {
+		t.Errorf("custom-columns eksik alanı %s olarak yazmalı:\n%s", None, got)
+	}
+	if got := render(t, ""); !strings.Contains(got, "NAME") {
+		t.Errorf("tablo başlığı etkin dile çevrilmeli:\n%s", got)
+	}
+}
+
+func TestCustomColumns(t *testing.T) {
+	out := render(t, "custom-columns=AD:.metadata.name,NS:metadata.namespace,NODE:.spec.nodeName")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("başlık ve iki satır bekleniyordu:\n%s", out)
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "AD NS NODE" {
+		t.Errorf("başlık satırı: %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "web default node-1" {
+		t.Errorf("ilk satır: %q", lines[1])
+	}
+	if !strings.Contains(lines[2], "<yok>") {
+		t.Errorf("eksik alan <yok> olarak gösterilmeli: %q", lines[2])
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	if got := render(t, "jsonpath={.items[*].metadata.name}"); got != "web db, primary\n" {
+		t.Errorf("jsonpath çıktısı: %q", got)
+	}
+	if got := render(t, `jsonpath={range .items[*]}{.kind}/{.metadata.name}{"\n"}{end}`); !strings.HasPrefix(got, "Pod/web\n") {
+		t.Errorf("range şablonu çıktısı: %q", got)
+	}
+}
+
+func TestNewPrinterErrors(t *testing.T) {
+	for _, format := range []string{"xml", "custom-columns", "custom-columns=AD", "jsonpath={.items[", "jsonpath"} {
+		if _, err := NewPrinter(format); err == nil {
+			t.Errorf("%q için hata bekleniyordu", format)
+		}
+	}
+}
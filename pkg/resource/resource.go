@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"tamerGoClient/pkg/auth"
+	"strings"
+	"tamerGoClient/pkg/i18n"
 	"tamerGoClient/pkg/info"
-	"time"
+	"tamerGoClient/pkg/session"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 )
@@ -87,13 +89,13 @@ func openInEditor(content string) (string, error) {
 	// Geçici dosya oluştur
 	tmpfile, err := os.CreateTemp("", "k8s-*.yaml")
 	if err != nil {
-		return "", fmt.Errorf("geçici dosya oluşturulamadı: %v", err)
+		return "", i18n.Errorf("geçici dosya oluşturulamadı: %v", err)
 	}
 	defer os.Remove(tmpfile.Name())
 
 	// İçeriği geçici dosyaya yaz
 	if err := os.WriteFile(tmpfile.Name(), []byte(content), 0644); err != nil {
-		return "", fmt.Errorf("dosya yazılamadı: %v", err)
+		return "", i18n.Errorf("dosya yazılamadı: %v", err)
 	}
 
 	// Tercih edilen editörü belirle
@@ -109,204 +111,286 @@ func openInEditor(content string) (string, error) {
 	cmd.Stderr = os.Stderr
 
 	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("editör çalıştırılamadı: %v", err)
+		return "", i18n.Errorf("editör çalıştırılamadı: %v", err)
 	}
 
 	// Düzenlenmiş içeriği oku
 	editedContent, err := os.ReadFile(tmpfile.Name())
 	if err != nil {
-		return "", fmt.Errorf("düzenlenmiş içerik okunamadı: %v", err)
+		return "", i18n.Errorf("düzenlenmiş içerik okunamadı: %v", err)
 	}
 
 	return string(editedContent), nil
 }
 
-func createFromYAML(yamlContent string) error {
+// templateNamespace varsayılan YAML şablonlarındaki örnek namespace
+const templateNamespace = "client-access"
+
+// withNamespace şablondaki örnek namespace'i oturumun varsayılan namespace'iyle değiştirir
+func withNamespace(s *session.Session, template string) string {
+	namespace := s.DefaultNamespace()
+	if namespace == "" {
+		return template
+	}
+	return strings.Replace(template, "namespace: "+templateNamespace, "namespace: "+namespace, 1)
+}
+
+// setDefaultNamespace namespace belirtilmemiş nesneleri oturumun varsayılan namespace'ine yerleştirir
+func setDefaultNamespace(s *session.Session, obj metav1.Object) {
+	if obj.GetNamespace() != "" {
+		return
+	}
+	namespace := s.DefaultNamespace()
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	obj.SetNamespace(namespace)
+}
+
+// CreateFromYAML YAML içeriğindeki Pod, Deployment veya Service'i oluşturur ve
+// oluşturulan nesneyi "tür/isim" biçiminde döndürür. Namespace belirtilmemişse
+// oturumun varsayılan namespace'i kullanılır.
+func CreateFromYAML(s *session.Session, yamlContent string) (string, error) {
 	decode := scheme.Codecs.UniversalDeserializer().Decode
 	obj, _, err := decode([]byte(yamlContent), nil, nil)
 	if err != nil {
-		return fmt.Errorf("YAML ayrıştırılamadı: %v", err)
+		return "", i18n.Errorf("YAML ayrıştırılamadı: %v", err)
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		setDefaultNamespace(s, accessor)
 	}
 
+	ctx, cancel := s.Context()
+	defer cancel()
+
 	// Resource türüne göre create işlemi yap
 	switch o := obj.(type) {
 	case *corev1.Pod:
-		_, err = auth.KubeClient.CoreV1().Pods(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		_, err = s.Client.CoreV1().Pods(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		return "pod/" + o.Name, err
+	case *appsv1.Deployment:
+		_, err = s.Client.AppsV1().Deployments(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		return "deployment/" + o.Name, err
+	case *corev1.Service:
+		_, err = s.Client.CoreV1().Services(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		return "service/" + o.Name, err
+	default:
+		return "", i18n.Errorf("desteklenmeyen resource tipi")
+	}
+}
+
+// UpdateFromYAML düzenlenmiş YAML ile mevcut kaynağı günceller ve
+// "tür/isim" biçiminde güncellenen kaynağı döndürür
+func UpdateFromYAML(s *session.Session, yamlContent string) (string, error) {
+	decode := scheme.Codecs.UniversalDeserializer().Decode
+	obj, _, err := decode([]byte(yamlContent), nil, nil)
+	if err != nil {
+		return "", i18n.Errorf("YAML ayrıştırılamadı: %v", err)
+	}
+
+	ctx, cancel := s.Context()
+	defer cancel()
+
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		_, err = s.Client.CoreV1().Pods(o.Namespace).Update(ctx, o, metav1.UpdateOptions{})
+		return "pod/" + o.Name, err
 	case *appsv1.Deployment:
-		_, err = auth.KubeClient.AppsV1().Deployments(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		_, err = s.Client.AppsV1().Deployments(o.Namespace).Update(ctx, o, metav1.UpdateOptions{})
+		return "deployment/" + o.Name, err
 	case *corev1.Service:
-		_, err = auth.KubeClient.CoreV1().Services(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		_, err = s.Client.CoreV1().Services(o.Namespace).Update(ctx, o, metav1.UpdateOptions{})
+		return "service/" + o.Name, err
 	default:
-		return fmt.Errorf("desteklenmeyen resource tipi")
+		return "", i18n.Errorf("desteklenmeyen resource tipi")
 	}
-	return err
 }
 
-func HandleResourceMenu() {
-	if auth.KubeClient == nil {
-		fmt.Println("\nUyarı: Önce bir Kubernetes cluster'ına bağlanmalısınız!")
+// Supports türün (tekil isim) oluşturma, güncelleme ve silme için desteklenip desteklenmediğini döndürür
+func Supports(kind string) bool {
+	switch kind {
+	case "pod", "deployment", "service":
+		return true
+	}
+	return false
+}
+
+// Delete desteklenen türdeki (pod, deployment, service) kaynağı siler
+func Delete(s *session.Session, kind, namespace, name string) error {
+	ctx, cancel := s.Context()
+	defer cancel()
+
+	switch kind {
+	case "pod":
+		return s.Client.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	case "deployment":
+		return s.Client.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	case "service":
+		return s.Client.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	default:
+		return i18n.Errorf("desteklenmeyen resource tipi: %s", kind)
+	}
+}
+
+func HandleResourceMenu(s *session.Session) {
+	if s == nil {
+		fmt.Println(i18n.T("\nUyarı: Önce bir Kubernetes cluster'ına bağlanmalısınız!"))
 		return
 	}
 
 	for {
-		fmt.Println("\n=== Instance Oluştur/Sil ===")
-		fmt.Println("1. Pod Yönetim Menüsü")
-		fmt.Println("2. Deployment Yönetim Menüsü")
-		fmt.Println("3. Service Yönetim Menüsü")
-		fmt.Println("4. Ana Menüye Dön")
-		fmt.Print("Seçiminiz (1-4): ")
+		fmt.Println(i18n.T("\n=== Instance Oluştur/Sil ==="))
+		fmt.Println(i18n.T("1. Pod Yönetim Menüsü"))
+		fmt.Println(i18n.T("2. Deployment Yönetim Menüsü"))
+		fmt.Println(i18n.T("3. Service Yönetim Menüsü"))
+		fmt.Println(i18n.T("4. Ana Menüye Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-4): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
 
 		switch choice {
 		case 1:
-			handlePodMenu()
+			handlePodMenu(s)
 		case 2:
-			handleDeploymentMenu()
+			handleDeploymentMenu(s)
 		case 3:
-			handleServiceMenu()
+			handleServiceMenu(s)
 		case 4:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func handlePodMenu() {
+func handlePodMenu(s *session.Session) {
 	for {
-		fmt.Println("\n=== Pod Yönetim Menüsü ===")
-		fmt.Println("1. Pod Oluştur")
-		fmt.Println("2. Pod Sil")
-		fmt.Println("3. Podları Listele")
-		fmt.Println("4. Önceki Menüye Dön")
-		fmt.Print("Seçiminiz (1-4): ")
+		fmt.Println(i18n.T("\n=== Pod Yönetim Menüsü ==="))
+		fmt.Println(i18n.T("1. Pod Oluştur"))
+		fmt.Println(i18n.T("2. Pod Sil"))
+		fmt.Println(i18n.T("3. Podları Listele"))
+		fmt.Println(i18n.T("4. Önceki Menüye Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-4): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
 
 		switch choice {
 		case 1:
-			createPod()
+			createPod(s)
 		case 2:
-			deletePod()
+			deletePod(s)
 		case 3:
-			info.ListPods()
+			info.ListPods(s)
 		case 4:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func handleDeploymentMenu() {
+func handleDeploymentMenu(s *session.Session) {
 	for {
-		fmt.Println("\n=== Deployment Yönetim Menüsü ===")
-		fmt.Println("1. Deployment Oluştur")
-		fmt.Println("2. Deployment Sil")
-		fmt.Println("3. Deploymentları Listele")
-		fmt.Println("4. Önceki Menüye Dön")
-		fmt.Print("Seçiminiz (1-4): ")
+		fmt.Println(i18n.T("\n=== Deployment Yönetim Menüsü ==="))
+		fmt.Println(i18n.T("1. Deployment Oluştur"))
+		fmt.Println(i18n.T("2. Deployment Sil"))
+		fmt.Println(i18n.T("3. Deploymentları Listele"))
+		fmt.Println(i18n.T("4. Önceki Menüye Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-4): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
 
 		switch choice {
 		case 1:
-			createDeployment()
+			createDeployment(s)
 		case 2:
-			deleteDeployment()
+			deleteDeployment(s)
 		case 3:
-			info.ListDeploymentsWithDetails()
+			info.ListDeploymentsWithDetails(s)
 		case 4:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func handleServiceMenu() {
+func handleServiceMenu(s *session.Session) {
 	for {
-		fmt.Println("\n=== Service Yönetim Menüsü ===")
-		fmt.Println("1. Service Oluştur")
-		fmt.Println("2. Service Sil")
-		fmt.Println("3. Service'leri Listele")
-		fmt.Println("4. Önceki Menüye Dön")
-		fmt.Print("Seçiminiz (1-4): ")
+		fmt.Println(i18n.T("\n=== Service Yönetim Menüsü ==="))
+		fmt.Println(i18n.T("1. Service Oluştur"))
+		fmt.Println(i18n.T("2. Service Sil"))
+		fmt.Println(i18n.T("3. Service'leri Listele"))
+		fmt.Println(i18n.T("4. Önceki Menüye Dön"))
+		fmt.Print(i18n.T("Seçiminiz (1-4): "))
 
 		var choice int
 		fmt.Scanf("%d", &choice)
 
 		switch choice {
 		case 1:
-			createService()
+			createService(s)
 		case 2:
-			deleteService()
+			deleteService(s)
 		case 3:
-			info.ListServicesWithDetails()
+			info.ListServicesWithDetails(s)
 		case 4:
 			return
 		default:
-			fmt.Println("Geçersiz seçim!")
+			fmt.Println(i18n.T("Geçersiz seçim!"))
 		}
 	}
 }
 
-func deletePod() {
+func deletePod(s *session.Session) {
 	// Mevcut podları listele
-	ctx := context.Background()
-	pods, err := auth.KubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	prompt := i18n.T("\nSilmek istediğiniz pod'un numarasını girin (0 için iptal): ")
+	pods, index, err := info.Browse(info.NewPager(s, info.Pods, metav1.ListOptions{}), prompt, func(pods *corev1.PodList) bool {
+		fmt.Println(i18n.T("\nMevcut Podlar:"))
+		fmt.Printf("%-5s %-30s %-20s %-12s\n", "NO", i18n.T("İSİM"), "NAMESPACE", i18n.T("DURUM"))
+
+		for i, pod := range pods.Items {
+			fmt.Printf("%-5d %-30s %-20s %-12s\n",
+				i+1,
+				pod.Name,
+				pod.Namespace,
+				string(pod.Status.Phase))
+		}
+		return true
+	})
 	if err != nil {
-		fmt.Printf("Pod listesi alınamadı: %v\n", err)
+		i18n.Printf("Pod listesi alınamadı: %v\n", err)
 		return
 	}
-
-	fmt.Println("\nMevcut Podlar:")
-	fmt.Printf("%-5s %-30s %-20s %-12s\n", "NO", "İSİM", "NAMESPACE", "DURUM")
-
-	podList := make([]corev1.Pod, 0)
-	for i, pod := range pods.Items {
-		fmt.Printf("%-5d %-30s %-20s %-12s\n",
-			i+1,
-			pod.Name,
-			pod.Namespace,
-			string(pod.Status.Phase))
-		podList = append(podList, pod)
-	}
-
-	fmt.Print("\nSilmek istediğiniz pod'un numarasını girin (0 için iptal): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
-
-	if choice > 0 && choice <= len(podList) {
-		selectedPod := podList[choice-1]
-		fmt.Printf("\nPod'u silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
+	if index >= 0 {
+		selectedPod := pods.Items[index]
+		i18n.Printf("\nPod'u silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
 			selectedPod.Namespace, selectedPod.Name)
 
 		var confirm string
 		fmt.Scanf("%s", &confirm)
 
-		if confirm == "e" {
-			err := auth.KubeClient.CoreV1().Pods(selectedPod.Namespace).Delete(ctx, selectedPod.Name, metav1.DeleteOptions{})
+		if i18n.IsYes(confirm) {
+			err := Delete(s, "pod", selectedPod.Namespace, selectedPod.Name)
 			if err != nil {
-				fmt.Printf("Pod silinemedi: %v\n", err)
+				i18n.Printf("Pod silinemedi: %v\n", err)
 			} else {
-				fmt.Println("Pod başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...")
-				time.Sleep(3 * time.Second)
-				info.ListPods()
+				fmt.Println(i18n.T("Pod başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz..."))
+				s.Settle()
+				info.ListPods(s)
 			}
 		}
 	}
 }
 
-func createPod() {
-	fmt.Println("\nVarsayılan Pod YAML editörde açılacak...")
-	editedContent, err := openInEditor(defaultPodYAML)
+func createPod(s *session.Session) {
+	fmt.Println(i18n.T("\nVarsayılan Pod YAML editörde açılacak..."))
+	editedContent, err := openInEditor(withNamespace(s, defaultPodYAML))
 	if err != nil {
-		fmt.Printf("Editör hatası: %v\n", err)
+		i18n.Printf("Editör hatası: %v\n", err)
 		return
 	}
 
@@ -314,34 +398,35 @@ func createPod() {
 	decode := scheme.Codecs.UniversalDeserializer().Decode
 	obj, _, err := decode([]byte(editedContent), nil, nil)
 	if err != nil {
-		fmt.Printf("YAML parse hatası: %v\n", err)
+		i18n.Printf("YAML parse hatası: %v\n", err)
 		return
 	}
 
 	if pod, ok := obj.(*corev1.Pod); ok {
+		setDefaultNamespace(s, pod)
 		// Pod'u oluştur
-		if err := createFromYAML(editedContent); err != nil {
-			fmt.Printf("Pod oluşturma hatası: %v\n", err)
+		if _, err := CreateFromYAML(s, editedContent); err != nil {
+			i18n.Printf("Pod oluşturma hatası: %v\n", err)
 		} else {
-			fmt.Println("Pod başarıyla oluşturuldu! 2 saniye sonra detaylar görüntülenecek...")
+			i18n.Printf("Pod başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n", s.Settings.SettleDelay)
 
 			// Pod'un oluşmasını bekle ve güncel bilgileri al
-			time.Sleep(2 * time.Second)
-			createdPod, err := auth.KubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+			s.Settle()
+			createdPod, err := s.Client.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
 			if err != nil {
-				fmt.Printf("Pod detayları alınamadı: %v\n", err)
+				i18n.Printf("Pod detayları alınamadı: %v\n", err)
 				return
 			}
-			info.ShowPodDetails(*createdPod)
+			info.ShowPodDetails(s, *createdPod)
 		}
 	}
 }
 
-func createDeployment() {
-	fmt.Println("\nVarsayılan Deployment YAML editörde açılacak...")
-	editedContent, err := openInEditor(defaultDeploymentYAML)
+func createDeployment(s *session.Session) {
+	fmt.Println(i18n.T("\nVarsayılan Deployment YAML editörde açılacak..."))
+	editedContent, err := openInEditor(withNamespace(s, defaultDeploymentYAML))
 	if err != nil {
-		fmt.Printf("Editör hatası: %v\n", err)
+		i18n.Printf("Editör hatası: %v\n", err)
 		return
 	}
 
@@ -349,84 +434,79 @@ func createDeployment() {
 	decode := scheme.Codecs.UniversalDeserializer().Decode
 	obj, _, err := decode([]byte(editedContent), nil, nil)
 	if err != nil {
-		fmt.Printf("YAML parse hatası: %v\n", err)
+		i18n.Printf("YAML parse hatası: %v\n", err)
 		return
 	}
 
 	if deployment, ok := obj.(*appsv1.Deployment); ok {
+		setDefaultNamespace(s, deployment)
 		// Deployment'ı oluştur
-		createdDeployment, err := auth.KubeClient.AppsV1().Deployments(deployment.Namespace).Create(context.Background(), deployment, metav1.CreateOptions{})
+		createdDeployment, err := s.Client.AppsV1().Deployments(deployment.Namespace).Create(context.Background(), deployment, metav1.CreateOptions{})
 		if err != nil {
-			fmt.Printf("Deployment oluşturma hatası: %v\n", err)
+			i18n.Printf("Deployment oluşturma hatası: %v\n", err)
 			return
 		}
 
-		fmt.Println("Deployment başarıyla oluşturuldu! 3 saniye sonra detaylar görüntülenecek...")
-		time.Sleep(3 * time.Second)
+		i18n.Printf("Deployment başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n", s.Settings.SettleDelay)
+		s.Settle()
 
 		// Güncel deployment bilgilerini al ve göster
-		updatedDeployment, err := auth.KubeClient.AppsV1().Deployments(createdDeployment.Namespace).Get(context.Background(), createdDeployment.Name, metav1.GetOptions{})
+		updatedDeployment, err := s.Client.AppsV1().Deployments(createdDeployment.Namespace).Get(context.Background(), createdDeployment.Name, metav1.GetOptions{})
 		if err != nil {
-			fmt.Printf("Deployment detayları alınamadı: %v\n", err)
+			i18n.Printf("Deployment detayları alınamadı: %v\n", err)
 			return
 		}
-		info.ShowDeploymentDetails(*updatedDeployment)
+		info.ShowDeploymentDetails(s, *updatedDeployment)
 	}
 }
 
-func deleteDeployment() {
+func deleteDeployment(s *session.Session) {
 	// Mevcut deploymentları listele
-	ctx := context.Background()
-	deployments, err := auth.KubeClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	prompt := i18n.T("\nSilmek istediğiniz deployment'ın numarasını girin (0 için iptal): ")
+	deployments, index, err := info.Browse(info.NewPager(s, info.Deployments, metav1.ListOptions{}), prompt, func(deployments *appsv1.DeploymentList) bool {
+		fmt.Println(i18n.T("\nMevcut Deploymentlar:"))
+		fmt.Printf("%-5s %-30s %-20s %-10s\n", "NO", i18n.T("İSİM"), "NAMESPACE", "REPLICAS")
+
+		for i, deploy := range deployments.Items {
+			fmt.Printf("%-5d %-30s %-20s %d/%d\n",
+				i+1,
+				deploy.Name,
+				deploy.Namespace,
+				deploy.Status.ReadyReplicas,
+				deploy.Status.Replicas)
+		}
+		return true
+	})
 	if err != nil {
-		fmt.Printf("Deployment listesi alınamadı: %v\n", err)
+		i18n.Printf("Deployment listesi alınamadı: %v\n", err)
 		return
 	}
-
-	fmt.Println("\nMevcut Deploymentlar:")
-	fmt.Printf("%-5s %-30s %-20s %-10s\n", "NO", "İSİM", "NAMESPACE", "REPLICAS")
-
-	deployList := make([]appsv1.Deployment, 0)
-	for i, deploy := range deployments.Items {
-		fmt.Printf("%-5d %-30s %-20s %d/%d\n",
-			i+1,
-			deploy.Name,
-			deploy.Namespace,
-			deploy.Status.ReadyReplicas,
-			deploy.Status.Replicas)
-		deployList = append(deployList, deploy)
-	}
-
-	fmt.Print("\nSilmek istediğiniz deployment'ın numarasını girin (0 için iptal): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
-
-	if choice > 0 && choice <= len(deployList) {
-		selectedDeploy := deployList[choice-1]
-		fmt.Printf("\nDeployment'ı silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
+	if index >= 0 {
+		selectedDeploy := deployments.Items[index]
+		i18n.Printf("\nDeployment'ı silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
 			selectedDeploy.Namespace, selectedDeploy.Name)
 
 		var confirm string
 		fmt.Scanf("%s", &confirm)
 
-		if confirm == "e" {
-			err := auth.KubeClient.AppsV1().Deployments(selectedDeploy.Namespace).Delete(ctx, selectedDeploy.Name, metav1.DeleteOptions{})
+		if i18n.IsYes(confirm) {
+			err := Delete(s, "deployment", selectedDeploy.Namespace, selectedDeploy.Name)
 			if err != nil {
-				fmt.Printf("Deployment silinemedi: %v\n", err)
+				i18n.Printf("Deployment silinemedi: %v\n", err)
 			} else {
-				fmt.Println("Deployment başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...")
-				time.Sleep(3 * time.Second)
-				info.ListDeploymentsWithDetails()
+				fmt.Println(i18n.T("Deployment başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz..."))
+				s.Settle()
+				info.ListDeploymentsWithDetails(s)
 			}
 		}
 	}
 }
 
-func createService() {
-	fmt.Println("\nVarsayılan Service YAML editörde açılacak...")
-	editedContent, err := openInEditor(defaultServiceYAML)
+func createService(s *session.Session) {
+	fmt.Println(i18n.T("\nVarsayılan Service YAML editörde açılacak..."))
+	editedContent, err := openInEditor(withNamespace(s, defaultServiceYAML))
 	if err != nil {
-		fmt.Printf("Editör hatası: %v\n", err)
+		i18n.Printf("Editör hatası: %v\n", err)
 		return
 	}
 
@@ -434,75 +514,70 @@ func createService() {
 	decode := scheme.Codecs.UniversalDeserializer().Decode
 	obj, _, err := decode([]byte(editedContent), nil, nil)
 	if err != nil {
-		fmt.Printf("YAML parse hatası: %v\n", err)
+		i18n.Printf("YAML parse hatası: %v\n", err)
 		return
 	}
 
 	if service, ok := obj.(*corev1.Service); ok {
+		setDefaultNamespace(s, service)
 		// Service'i oluştur
-		createdService, err := auth.KubeClient.CoreV1().Services(service.Namespace).Create(context.Background(), service, metav1.CreateOptions{})
+		createdService, err := s.Client.CoreV1().Services(service.Namespace).Create(context.Background(), service, metav1.CreateOptions{})
 		if err != nil {
-			fmt.Printf("Service oluşturma hatası: %v\n", err)
+			i18n.Printf("Service oluşturma hatası: %v\n", err)
 			return
 		}
 
-		fmt.Println("Service başarıyla oluşturuldu! 2 saniye sonra detaylar görüntülenecek...")
-		time.Sleep(2 * time.Second)
+		i18n.Printf("Service başarıyla oluşturuldu! %v sonra detaylar görüntülenecek...\n", s.Settings.SettleDelay)
+		s.Settle()
 
 		// Güncel service bilgilerini al ve göster
-		updatedService, err := auth.KubeClient.CoreV1().Services(createdService.Namespace).Get(context.Background(), createdService.Name, metav1.GetOptions{})
+		updatedService, err := s.Client.CoreV1().Services(createdService.Namespace).Get(context.Background(), createdService.Name, metav1.GetOptions{})
 		if err != nil {
-			fmt.Printf("Service detayları alınamadı: %v\n", err)
+			i18n.Printf("Service detayları alınamadı: %v\n", err)
 			return
 		}
-		info.ShowServiceDetails(*updatedService)
+		info.ShowServiceDetails(s, *updatedService)
 	}
 }
 
-func deleteService() {
+func deleteService(s *session.Session) {
 	// Mevcut service'leri listele
-	ctx := context.Background()
-	services, err := auth.KubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+	prompt := i18n.T("\nSilmek istediğiniz service'in numarasını girin (0 için iptal): ")
+	services, index, err := info.Browse(info.NewPager(s, info.Services, metav1.ListOptions{}), prompt, func(services *corev1.ServiceList) bool {
+		fmt.Println(i18n.T("\nMevcut Service'ler:"))
+		fmt.Printf("%-5s %-30s %-20s %-15s %-15s\n",
+			"NO", i18n.T("İSİM"), "NAMESPACE", "TYPE", "CLUSTER-IP")
+
+		for i, svc := range services.Items {
+			fmt.Printf("%-5d %-30s %-20s %-15s %-15s\n",
+				i+1,
+				svc.Name,
+				svc.Namespace,
+				svc.Spec.Type,
+				svc.Spec.ClusterIP)
+		}
+		return true
+	})
 	if err != nil {
-		fmt.Printf("Service listesi alınamadı: %v\n", err)
+		i18n.Printf("Service listesi alınamadı: %v\n", err)
 		return
 	}
-
-	fmt.Println("\nMevcut Service'ler:")
-	fmt.Printf("%-5s %-30s %-20s %-15s %-15s\n",
-		"NO", "İSİM", "NAMESPACE", "TYPE", "CLUSTER-IP")
-
-	svcList := make([]corev1.Service, 0)
-	for i, svc := range services.Items {
-		fmt.Printf("%-5d %-30s %-20s %-15s %-15s\n",
-			i+1,
-			svc.Name,
-			svc.Namespace,
-			svc.Spec.Type,
-			svc.Spec.ClusterIP)
-		svcList = append(svcList, svc)
-	}
-
-	fmt.Print("\nSilmek istediğiniz service'in numarasını girin (0 için iptal): ")
-	var choice int
-	fmt.Scanf("%d", &choice)
-
-	if choice > 0 && choice <= len(svcList) {
-		selectedSvc := svcList[choice-1]
-		fmt.Printf("\nService'i silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
+	if index >= 0 {
+		selectedSvc := services.Items[index]
+		i18n.Printf("\nService'i silmek istediğinizden emin misiniz? (%s/%s) [e/h]: ",
 			selectedSvc.Namespace, selectedSvc.Name)
 
 		var confirm string
 		fmt.Scanf("%s", &confirm)
 
-		if confirm == "e" {
-			err := auth.KubeClient.CoreV1().Services(selectedSvc.Namespace).Delete(ctx, selectedSvc.Name, metav1.DeleteOptions{})
+		if i18n.IsYes(confirm) {
+			err := Delete(s, "service", selectedSvc.Namespace, selectedSvc.Name)
 			if err != nil {
-				fmt.Printf("Service silinemedi: %v\n", err)
+				i18n.Printf("Service silinemedi: %v\n", err)
 			} else {
-				fmt.Println("Service başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz...")
-				time.Sleep(2 * time.Second)
-				info.ListServicesWithDetails()
+				fmt.Println(i18n.T("Service başarıyla silindi! Etkilerin görüntülenmesi için bir süre bekleyiniz..."))
+				s.Settle()
+				info.ListServicesWithDetails(s)
 			}
 		}
 	}
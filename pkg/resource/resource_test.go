@@ -0,0 +1,182 @@
+package resource
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"tamerGoClient/internal/testutil"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+func TestCreateFromYAML(t *testing.T) {
+	s := testutil.NewSession()
+	ctx := context.Background()
+
+	for content, want := range map[string]string{
+		defaultPodYAML:        "pod/my-pod",
+		defaultDeploymentYAML: "deployment/my-deployment",
+		defaultServiceYAML:    "service/my-service",
+	} {
+		created, err := CreateFromYAML(s, content)
+		if err != nil {
+			t.Fatalf("CreateFromYAML: %v", err)
+		}
+		if created != want {
+			t.Errorf("CreateFromYAML = %q, %q beklendi", created, want)
+		}
+	}
+
+	if _, err := s.Client.CoreV1().Pods("client-access").Get(ctx, "my-pod", metav1.GetOptions{}); err != nil {
+		t.Errorf("pod oluşturulmadı: %v", err)
+	}
+	deploy, err := s.Client.AppsV1().Deployments("client-access").Get(ctx, "my-deployment", metav1.GetOptions{})
+	if err != nil {
+		t.Errorf("deployment oluşturulmadı: %v", err)
+	} else if *deploy.Spec.Replicas != 2 {
+		t.Errorf("replicas = %d, 2 beklendi", *deploy.Spec.Replicas)
+	}
+	if _, err := s.Client.CoreV1().Services("client-access").Get(ctx, "my-service", metav1.GetOptions{}); err != nil {
+		t.Errorf("service oluşturulmadı: %v", err)
+	}
+}
+
+func TestCreateFromYAMLErrors(t *testing.T) {
+	s := testutil.NewSession(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "client-access"}})
+
+	if _, err := CreateFromYAML(s, "bu: [geçerli değil"); err == nil {
+		t.Error("bozuk YAML için hata bekleniyordu")
+	}
+	configMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"
+	if _, err := CreateFromYAML(s, configMap); err == nil || !strings.Contains(err.Error(), "desteklenmeyen") {
+		t.Errorf("desteklenmeyen tip hatası bekleniyordu, alınan: %v", err)
+	}
+	if _, err := CreateFromYAML(s, defaultPodYAML); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("AlreadyExists hatası bekleniyordu, alınan: %v", err)
+	}
+}
+
+func TestCreateFromYAMLUsesDefaultNamespace(t *testing.T) {
+	s := testutil.NewSession()
+	s.Namespace = "team-a"
+
+	content := "apiVersion: v1\nkind: Service\nmetadata:\n  name: api\nspec:\n  ports:\n  - port: 80\n"
+	if _, err := CreateFromYAML(s, content); err != nil {
+		t.Fatalf("CreateFromYAML: %v", err)
+	}
+	if _, err := s.Client.CoreV1().Services("team-a").Get(context.Background(), "api", metav1.GetOptions{}); err != nil {
+		t.Errorf("service varsayılan namespace'te oluşturulmalıydı: %v", err)
+	}
+
+	if got := withNamespace(s, defaultPodYAML); !strings.Contains(got, "namespace: team-a") {
+		t.Errorf("şablon namespace'i güncellenmedi:\n%s", got)
+	}
+}
+
+func TestDeletePod(t *testing.T) {
+	s := testutil.NewSession(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}},
+	)
+
+	// 2. pod seçilir, onaylanır, ardından gösterilen listeden çıkılır
+	out := testutil.RunWithInput(t, "2\ne\n0\n", func() { deletePod(s) })
+	if !strings.Contains(out, "Pod başarıyla silindi") {
+		t.Errorf("başarı mesajı bekleniyordu:\n%s", out)
+	}
+
+	pods, err := s.Client.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "a" {
+		t.Errorf("yalnızca 'a' podu kalmalıydı: %v", pods.Items)
+	}
+}
+
+func TestDeletePodCancelled(t *testing.T) {
+	s := testutil.NewSession(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}})
+
+	testutil.RunWithInput(t, "1\nh\n", func() { deletePod(s) })
+
+	if _, err := s.Client.CoreV1().Pods("default").Get(context.Background(), "a", metav1.GetOptions{}); err != nil {
+		t.Errorf("onay verilmeden pod silinmemeliydi: %v", err)
+	}
+}
+
+func TestDeleteDeployment(t *testing.T) {
+	s := testutil.NewSession(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}})
+
+	testutil.RunWithInput(t, "1\ne\n0\n", func() { deleteDeployment(s) })
+
+	_, err := s.Client.AppsV1().Deployments("default").Get(context.Background(), "api", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("deployment silinmeliydi, alınan: %v", err)
+	}
+}
+
+func TestDeleteService(t *testing.T) {
+	s := testutil.NewSession(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"}})
+
+	testutil.RunWithInput(t, "1\ne\n0\n", func() { deleteService(s) })
+
+	_, err := s.Client.CoreV1().Services("default").Get(context.Background(), "frontend", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("service silinmeliydi, alınan: %v", err)
+	}
+}
+
+func TestDeleteUnsupportedKind(t *testing.T) {
+	if err := Delete(testutil.NewSession(), "configmap", "default", "x"); err == nil {
+		t.Error("desteklenmeyen tür için hata bekleniyordu")
+	}
+}
+
+func TestDeleteRespectsSessionNamespace(t *testing.T) {
+	s := testutil.NewSession(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "client-access"}},
+	)
+	s.Namespace = "client-access"
+
+	// Listede yalnızca client-access/b bulunduğundan 1. seçim onu siler
+	testutil.RunWithInput(t, "1\ne\n0\n", func() { deletePod(s) })
+
+	ctx := context.Background()
+	if _, err := s.Client.CoreV1().Pods("default").Get(ctx, "a", metav1.GetOptions{}); err != nil {
+		t.Errorf("başka namespace'teki pod silinmemeliydi: %v", err)
+	}
+	if _, err := s.Client.CoreV1().Pods("client-access").Get(ctx, "b", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("client-access/b silinmeliydi, alınan: %v", err)
+	}
+}
+
+func TestUpdateFromYAML(t *testing.T) {
+	s := testutil.NewSession(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}})
+
+	content := "apiVersion: v1\nkind: Service\nmetadata:\n  name: api\n  namespace: default\n  labels:\n    tier: backend\n"
+	updated, err := UpdateFromYAML(s, content)
+	if err != nil || updated != "service/api" {
+		t.Fatalf("UpdateFromYAML = %q, %v", updated, err)
+	}
+	svc, err := s.Client.CoreV1().Services("default").Get(context.Background(), "api", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.Labels["tier"] != "backend" {
+		t.Errorf("label güncellenmeliydi: %v", svc.Labels)
+	}
+
+	missing := strings.Replace(content, "name: api", "name: yok", 1)
+	if _, err := UpdateFromYAML(s, missing); !apierrors.IsNotFound(err) {
+		t.Errorf("olmayan kaynak için NotFound bekleniyordu, alınan: %v", err)
+	}
+}
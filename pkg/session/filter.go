@@ -0,0 +1,116 @@
+package session
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Filter oturum boyunca listelere uygulanan filtreler. Label ve field
+// selector'lar sunucu tarafında uygulanır; Kubernetes isimde alt metin veya
+// regex aramasını desteklemediği için isim filtresi sonuçlar üzerinde uygulanır.
+// İsim filtresi varsayılan olarak düz metin aranır; /^api-/ gibi eğik çizgiler
+// arasına yazılan değer regex olarak yorumlanır.
+//
+// Field selector'ların desteklenen alanları türe göre değiştiğinden (örn.
+// status.phase yalnızca podlarda var) field selector yalnızca girildiği türe
+// uygulanır. Label selector türü belirtilmemişse namespace'li tüm türlere
+// uygulanır; namespace, node ve PV gibi cluster kapsamlı listeler etkilenmez.
+type Filter struct {
+	LabelSelector     string // örn. app=web,tier!=db
+	LabelSelectorKind string // Boşsa namespace'li tüm türler, değilse yalnızca bu tür (örn. pods)
+	FieldSelector     string // örn. status.phase=Running
+	FieldSelectorKind string // Field selector'ın uygulanacağı tür (örn. pods)
+	Name              string // Büyük/küçük harf duyarsız düz metin veya /regex/
+}
+
+// IsEmpty hiçbir filtrenin tanımlı olmadığını döndürür
+func (f Filter) IsEmpty() bool {
+	return f == Filter{}
+}
+
+// Validate selector'ların ve isim regex'inin geçerli olduğunu doğrular
+func (f Filter) Validate() error {
+	if _, err := labels.Parse(f.LabelSelector); err != nil {
+		return i18n.Errorf("geçersiz label selector: %v", err)
+	}
+	if _, err := fields.ParseSelector(f.FieldSelector); err != nil {
+		return i18n.Errorf("geçersiz field selector: %v", err)
+	}
+	if f.FieldSelector != "" && f.FieldSelectorKind == "" {
+		return errors.New(i18n.T("field selector için kaynak türü belirtilmeli"))
+	}
+	if _, err := f.NameMatcher(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Apply filtrenin kind türüne uygulanan selector'larını isteğin
+// selector'larıyla birleştirir; virgülle birleştirilen koşulların hepsi
+// sağlanmalıdır. kind çoğul tür ismidir (örn. pods), namespaced türün
+// namespace kapsamlı olup olmadığını belirtir.
+func (f Filter) Apply(kind string, namespaced bool, opts metav1.ListOptions) metav1.ListOptions {
+	if f.LabelSelectorKind == kind || f.LabelSelectorKind == "" && namespaced {
+		opts.LabelSelector = joinSelectors(opts.LabelSelector, f.LabelSelector)
+	}
+	if f.FieldSelectorKind == kind {
+		opts.FieldSelector = joinSelectors(opts.FieldSelector, f.FieldSelector)
+	}
+	return opts
+}
+
+func joinSelectors(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + "," + b
+}
+
+// NameMatcher isim filtresine uyan isimler için true dönen bir fonksiyon
+// döndürür. Filtre boşsa tüm isimler eşleşir.
+func (f Filter) NameMatcher() (func(name string) bool, error) {
+	if f.Name == "" {
+		return func(string) bool { return true }, nil
+	}
+	expr := regexp.QuoteMeta(f.Name)
+	if len(f.Name) >= 2 && strings.HasPrefix(f.Name, "/") && strings.HasSuffix(f.Name, "/") {
+		expr = f.Name[1 : len(f.Name)-1]
+	}
+	pattern, err := regexp.Compile("(?i)" + expr)
+	if err != nil {
+		return nil, i18n.Errorf("geçersiz isim filtresi: %v", err)
+	}
+	return pattern.MatchString, nil
+}
+
+// String menü başlıklarında gösterilecek kısa açıklamayı döndürür
+func (f Filter) String() string {
+	var parts []string
+	if f.LabelSelector != "" {
+		parts = append(parts, "-l "+f.LabelSelector+kindSuffix(f.LabelSelectorKind))
+	}
+	if f.FieldSelector != "" {
+		parts = append(parts, "--field-selector "+f.FieldSelector+kindSuffix(f.FieldSelectorKind))
+	}
+	if f.Name != "" {
+		parts = append(parts, i18n.Sprintf("isim~%s", f.Name))
+	}
+	if len(parts) == 0 {
+		return i18n.T("yok")
+	}
+	return strings.Join(parts, " ")
+}
+
+func kindSuffix(kind string) string {
+	if kind == "" {
+		return ""
+	}
+	return " (" + kind + ")"
+}
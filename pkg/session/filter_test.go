@@ -0,0 +1,91 @@
+package session
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFilterApply(t *testing.T) {
+	filter := Filter{LabelSelector: "app=web", FieldSelector: "status.phase=Running", FieldSelectorKind: "pods"}
+
+	opts := filter.Apply("pods", true, metav1.ListOptions{FieldSelector: "metadata.name=a"})
+	if opts.LabelSelector != "app=web" {
+		t.Errorf("LabelSelector = %q", opts.LabelSelector)
+	}
+	if opts.FieldSelector != "metadata.name=a,status.phase=Running" {
+		t.Errorf("selector'lar birleştirilmeliydi: %q", opts.FieldSelector)
+	}
+
+	// Field selector başka türe, label selector cluster kapsamlı türlere uygulanmaz
+	if opts := filter.Apply("services", true, metav1.ListOptions{}); opts.LabelSelector != "app=web" || opts.FieldSelector != "" {
+		t.Errorf("services için yalnızca label selector uygulanmalı: %+v", opts)
+	}
+	if opts := filter.Apply("nodes", false, metav1.ListOptions{}); opts.LabelSelector != "" || opts.FieldSelector != "" {
+		t.Errorf("nodes için filtre uygulanmamalı: %+v", opts)
+	}
+
+	// Türü belirtilen label selector cluster kapsamlı olsa da o türe uygulanır
+	nodes := Filter{LabelSelector: "role=worker", LabelSelectorKind: "nodes"}
+	if opts := nodes.Apply("nodes", false, metav1.ListOptions{}); opts.LabelSelector != "role=worker" {
+		t.Errorf("nodes label selector uygulanmalı: %+v", opts)
+	}
+	if opts := nodes.Apply("pods", true, metav1.ListOptions{}); opts.LabelSelector != "" {
+		t.Errorf("nodes label selector podlara uygulanmamalı: %+v", opts)
+	}
+
+	if opts := (Filter{}).Apply("pods", true, metav1.ListOptions{LabelSelector: "x"}); opts.LabelSelector != "x" || opts.FieldSelector != "" {
+		t.Errorf("boş filtre isteği değiştirmemeli: %+v", opts)
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	valid := Filter{LabelSelector: "app in (web,api),!legacy", FieldSelector: "spec.nodeName!=n1", FieldSelectorKind: "pods", Name: "/^web-/"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("geçerli filtre reddedildi: %v", err)
+	}
+	for _, filter := range []Filter{
+		{LabelSelector: "app=("},
+		{FieldSelector: "status.phase", FieldSelectorKind: "pods"},
+		{FieldSelector: "status.phase=Running"},
+		{Name: "/web(/"},
+	} {
+		if err := filter.Validate(); err == nil {
+			t.Errorf("%+v geçersiz sayılmalıydı", filter)
+		}
+	}
+}
+
+func TestFilterNameMatcher(t *testing.T) {
+	match, err := Filter{Name: "WEB"}.NameMatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{"web": true, "my-web-1": true, "api": false} {
+		if got := match(name); got != want {
+			t.Errorf("match(%q) = %v, beklenen %v", name, got, want)
+		}
+	}
+
+	match, err = Filter{Name: "web("}.NameMatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !match("my-web(1)") || match("web-1") {
+		t.Error("isim filtresi düz metin olarak aranmalı")
+	}
+
+	match, _ = Filter{Name: "/^api-[0-9]+$/"}.NameMatcher()
+	if !match("api-12") || match("api-x") || match("my-api-1") {
+		t.Error("regex isim filtresi doğru uygulanmadı")
+	}
+}
+
+func TestFilterIsEmpty(t *testing.T) {
+	if !(Filter{}).IsEmpty() || (Filter{Name: "x"}).IsEmpty() {
+		t.Error("IsEmpty yanlış sonuç verdi")
+	}
+	if s := DefaultSettings(); !s.Filter.IsEmpty() {
+		t.Error("varsayılan ayarlarda filtre olmamalı")
+	}
+}
@@ -0,0 +1,205 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"tamerGoClient/pkg/i18n"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Settings oturuma özel, menülerden değiştirilebilen tercihler
+type Settings struct {
+	RequestTimeout time.Duration // Listeleme/okuma isteklerinin zaman aşımı
+	SettleDelay    time.Duration // Oluşturma/silme sonrası güncel durumu göstermeden önce beklenecek süre
+	Output         string        // Listelerin varsayılan çıktı biçimi (boşsa tablo, bkz. output.NewPrinter)
+	Filter         Filter        // Tüm listelere uygulanan label/field/isim filtreleri
+	PageSize       int64         // Menü listelerinde sayfa başına (ve istek başına) öğe sayısı, 0 ise sayfalama yapılmaz
+	Debug          bool          // Listelemelerin yaptığı API çağrısı sayısını ve süresini stderr'e yazar
+}
+
+// DefaultSettings yeni oturumlar için varsayılan tercihler
+func DefaultSettings() Settings {
+	return Settings{
+		RequestTimeout: 10 * time.Second,
+		SettleDelay:    3 * time.Second,
+		PageSize:       50,
+	}
+}
+
+// Session tek bir cluster bağlantısını ve ona ait tercihleri taşır. Menü
+// fonksiyonları global client yerine bu yapıyı parametre olarak alır; böylece
+// aynı anda birden fazla cluster ile çalışılabilir ve fake client ile test
+// edilebilir.
+type Session struct {
+	Name      string               // Menü başlıklarında gösterilen bağlantı açıklaması
+	Client    kubernetes.Interface // Typed client
+	Dynamic   dynamic.Interface    // CRD'ler ve tipi bilinmeyen kaynaklar için
+	Config    *rest.Config         // Client'ların oluşturulduğu config (fake oturumlarda nil)
+	Namespace string               // Listelerin ve oluşturmaların varsayılan namespace'i, boşsa tüm namespace'ler
+	Settings  Settings
+
+	// FallbackNamespace cluster genelinde listeleme yetkisi olmadığında
+	// kullanılacak namespace (yapılandırmadan, kubeconfig'den veya pod içinden tespit edilir)
+	FallbackNamespace string
+
+	apiCalls        atomic.Int64 // Client'ların API sunucusuna gönderdiği istek sayısı
+	backgroundCalls atomic.Int64 // Bunlardan WithBackground ile işaretlenmiş olanlar ayrıca sayılır
+}
+
+// New config'den typed ve dynamic client'ları oluşturarak yeni bir oturum döndürür
+func New(name string, config *rest.Config) (*Session, error) {
+	s := &Session{Name: name, Settings: DefaultSettings()}
+	if err := s.SetConfig(config); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetConfig oturumun client'larını yeni config ile yeniden oluşturur. Namespace
+// ve tercihler korunur; yeniden kimlik doğrulama ve kimliğe bürünme bunu kullanır.
+func (s *Session) SetConfig(config *rest.Config) error {
+	// Client'lar istekleri sayan bir kopyayla oluşturulur; Config alanında
+	// exec ve port-forward gibi işlemler için orijinal config saklanır
+	counted := rest.CopyConfig(config)
+	counted.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Context().Value(backgroundKey{}) != nil {
+				s.backgroundCalls.Add(1)
+			} else {
+				s.apiCalls.Add(1)
+			}
+			return rt.RoundTrip(req)
+		})
+	})
+
+	client, err := kubernetes.NewForConfig(counted)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(counted)
+	if err != nil {
+		return err
+	}
+	s.Client, s.Dynamic, s.Config = client, dynamicClient, config
+	return nil
+}
+
+// StreamingClient log takibi gibi süresi belirsiz akışlar için http zaman
+// aşımı olmayan bir client döndürür; akışın süresi context ile sınırlanır.
+// Config'de zaman aşımı yoksa oturumun client'ı kullanılır.
+func (s *Session) StreamingClient() (kubernetes.Interface, error) {
+	if s.Config == nil || s.Config.Timeout == 0 {
+		return s.Client, nil
+	}
+	config := rest.CopyConfig(s.Config)
+	config.Timeout = 0
+	return kubernetes.NewForConfig(config)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type backgroundKey struct{}
+
+// WithBackground ctx ile yapılan istekleri arka plan isteği olarak işaretler;
+// bu istekler APICalls'a ve Measure ölçümlerine katılmaz
+func WithBackground(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backgroundKey{}, true)
+}
+
+// APICalls oturum açıldığından beri API sunucusuna gönderilen, arka plan
+// istekleri dışındaki istek sayısını döndürür
+func (s *Session) APICalls() int64 {
+	return s.apiCalls.Load()
+}
+
+// Measure debug modunda bir işlemin yaptığı API çağrılarını ve geçen süreyi
+// ölçmeye başlar; dönen fonksiyon sonucu verilen açıklamayla stderr'e yazar.
+// Debug kapalıysa hiçbir şey yazılmaz.
+func (s *Session) Measure() func(label string) {
+	return s.measure(&s.apiCalls)
+}
+
+// MeasureBackground Measure gibidir ama yalnızca WithBackground ile
+// işaretlenmiş istekleri sayar
+func (s *Session) MeasureBackground() func(label string) {
+	return s.measure(&s.backgroundCalls)
+}
+
+func (s *Session) measure(counter *atomic.Int64) func(label string) {
+	if !s.Settings.Debug {
+		return func(string) {}
+	}
+	start, calls := time.Now(), counter.Load()
+	return func(label string) {
+		i18n.Fprintf(os.Stderr, "[debug] %s: %d API çağrısı, %v\n", label, counter.Load()-calls, time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// Context oturumun istek zaman aşımıyla sınırlı bir context döndürür
+func (s *Session) Context() (context.Context, context.CancelFunc) {
+	if s.Settings.RequestTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), s.Settings.RequestTimeout)
+}
+
+// Settle oluşturma/silme işlemlerinin etkisinin görünmesi için bekler
+func (s *Session) Settle() {
+	time.Sleep(s.Settings.SettleDelay)
+}
+
+// DefaultNamespace yeni oluşturulacak kaynaklar için namespace'i döndürür.
+// Tüm namespace'ler seçiliyse yedek namespace, o da yoksa boş string döner.
+func (s *Session) DefaultNamespace() string {
+	if s.Namespace != "" {
+		return s.Namespace
+	}
+	return s.FallbackNamespace
+}
+
+// NamespaceLabel menü başlıklarında gösterilecek namespace açıklamasını döndürür
+func (s *Session) NamespaceLabel() string {
+	if s.Namespace == "" {
+		return i18n.T("tümü")
+	}
+	return s.Namespace
+}
+
+// ScopeToFallback cluster genelindeki bir istek Forbidden ile reddedildiyse
+// oturumu yedek namespace'e daraltır ve true döner; çağıran isteği tekrarlamalıdır.
+// Bildirimler komut satırı çıktısını bozmamak için stderr'e yazılır.
+func (s *Session) ScopeToFallback(err error) bool {
+	if s.Namespace != "" || !apierrors.IsForbidden(err) {
+		return false
+	}
+	if s.FallbackNamespace == "" {
+		fmt.Fprintln(os.Stderr, i18n.T("İpucu: Tüm namespace'leri listeleme yetkiniz yok. Kimlik Doğrulama menüsünden varsayılan namespace seçin veya K8S_NAMESPACE tanımlayın."))
+		return false
+	}
+	s.Namespace = s.FallbackNamespace
+	i18n.Fprintf(os.Stderr, "Uyarı: Tüm namespace'leri listeleme yetkiniz yok, '%s' namespace'ine geçildi.\n", s.Namespace)
+	return true
+}
+
+// List namespace'li bir listeleme isteğini oturumun namespace'iyle çalıştırır.
+// Cluster genelinde yetki yoksa istek yedek namespace'te bir kez daha denenir.
+func List[T any](s *Session, list func(namespace string) (T, error)) (T, error) {
+	result, err := list(s.Namespace)
+	if s.ScopeToFallback(err) {
+		result, err = list(s.Namespace)
+	}
+	return result, err
+}
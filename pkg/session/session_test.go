@@ -0,0 +1,180 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestSetConfigKeepsNamespaceAndSettings(t *testing.T) {
+	s, err := New("test", &rest.Config{Host: "https://one.example:6443"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if s.Settings != DefaultSettings() {
+		t.Errorf("varsayılan ayarlar bekleniyordu: %+v", s.Settings)
+	}
+	s.Namespace = "client-access"
+	s.Settings.SettleDelay = 0
+	oldClient := s.Client
+
+	if err := s.SetConfig(&rest.Config{Host: "https://two.example:6443"}); err != nil {
+		t.Fatalf("SetConfig: %v", err)
+	}
+	if s.Client == oldClient {
+		t.Error("client yeniden oluşturulmalıydı")
+	}
+	if s.Config.Host != "https://two.example:6443" {
+		t.Errorf("config güncellenmedi: %s", s.Config.Host)
+	}
+	if s.Namespace != "client-access" || s.Settings.SettleDelay != 0 {
+		t.Errorf("namespace ve ayarlar korunmalıydı: %q %+v", s.Namespace, s.Settings)
+	}
+}
+
+func TestSetConfigInvalid(t *testing.T) {
+	s := &Session{}
+	if err := s.SetConfig(&rest.Config{Host: "https://example:6443", QPS: 1, Burst: 0}); err == nil {
+		t.Error("burst olmadan QPS için hata bekleniyordu")
+	}
+	if s.Client != nil {
+		t.Error("hata durumunda client değişmemeliydi")
+	}
+}
+
+func TestContext(t *testing.T) {
+	s := &Session{Settings: Settings{RequestTimeout: time.Minute}}
+	ctx, cancel := s.Context()
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Minute {
+		t.Errorf("bir dakikalık zaman aşımı bekleniyordu: %v %v", deadline, ok)
+	}
+
+	s.Settings.RequestTimeout = 0
+	ctx, cancel = s.Context()
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("sıfır zaman aşımında deadline olmamalıydı")
+	}
+}
+
+// namespaceScopedClient cluster genelindeki pod listelemeyi Forbidden ile reddeden fake client döndürür
+func namespaceScopedClient(objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewClientset(objects...)
+	client.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", nil)
+		}
+		return false, nil, nil
+	})
+	return client
+}
+
+func listPods(s *Session) (*corev1.PodList, error) {
+	return List(s, func(namespace string) (*corev1.PodList, error) {
+		return s.Client.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{})
+	})
+}
+
+func TestListFallsBackOnForbidden(t *testing.T) {
+	s := &Session{
+		Client: namespaceScopedClient(
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "mine", Namespace: "tenant"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}},
+		),
+		FallbackNamespace: "tenant",
+	}
+
+	pods, err := listPods(s)
+	if err != nil {
+		t.Fatalf("yedek namespace'e düşülmeliydi: %v", err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "mine" {
+		t.Errorf("yalnızca tenant namespace'indeki pod beklendi: %v", pods.Items)
+	}
+	if s.Namespace != "tenant" {
+		t.Errorf("oturum yedek namespace'e daraltılmalıydı: %q", s.Namespace)
+	}
+}
+
+func TestListWithoutFallbackReturnsForbidden(t *testing.T) {
+	s := &Session{Client: namespaceScopedClient()}
+
+	if _, err := listPods(s); !apierrors.IsForbidden(err) {
+		t.Errorf("Forbidden hatası bekleniyordu, alınan: %v", err)
+	}
+	if s.Namespace != "" {
+		t.Errorf("namespace değişmemeliydi: %q", s.Namespace)
+	}
+}
+
+func TestDefaultNamespace(t *testing.T) {
+	s := &Session{FallbackNamespace: "tenant"}
+	if got := s.DefaultNamespace(); got != "tenant" {
+		t.Errorf("DefaultNamespace() = %q, yedek namespace beklendi", got)
+	}
+	s.Namespace = "team-a"
+	if got := s.DefaultNamespace(); got != "team-a" {
+		t.Errorf("DefaultNamespace() = %q, seçili namespace beklendi", got)
+	}
+}
+
+func TestAPICallsCounted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"PodList","apiVersion":"v1","items":[]}`))
+	}))
+	defer server.Close()
+
+	s, err := New("test", &rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, err := s.Client.CoreV1().Pods("").List(context.Background(), metav1.ListOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.Client.CoreV1().Pods("").List(WithBackground(context.Background()), metav1.ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := s.APICalls(); calls != 2 {
+		t.Errorf("APICalls = %d, arka plan isteği hariç 2 beklendi", calls)
+	}
+	if calls := s.backgroundCalls.Load(); calls != 1 {
+		t.Errorf("arka plan istekleri = %d, 1 beklendi", calls)
+	}
+	if s.Config.WrapTransport != nil {
+		t.Error("sayaç oturumun sakladığı config'e eklenmemeli")
+	}
+}
+
+func TestStreamingClientIgnoresHTTPTimeout(t *testing.T) {
+	s, err := New("test", &rest.Config{Host: "https://one.example:6443"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client, err := s.StreamingClient(); err != nil || client != s.Client {
+		t.Errorf("zaman aşımı yokken oturumun client'ı kullanılmalı (%v)", err)
+	}
+
+	s.Config.Timeout = 30 * time.Second
+	client, err := s.StreamingClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client == s.Client || s.Config.Timeout != 30*time.Second {
+		t.Error("akışlar için zaman aşımı olmayan ayrı bir client oluşturulmalı, oturum config'i değişmemeli")
+	}
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"bufio"
+	"unicode/utf8"
+)
+
+// key raw moddaki terminalden okunan tuşun türü
+type key int
+
+const (
+	keyRune key = iota // Yazdırılabilir karakter, değeri keyEvent.r'de
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyPageUp
+	keyPageDown
+	keyHome
+	keyEnd
+	keyEnter
+	keyTab
+	keyEsc
+	keyCtrlC
+	keyUnknown
+)
+
+type keyEvent struct {
+	key key
+	r   rune
+}
+
+// readKey tek bir tuşu okur. Ok tuşları gibi escape dizileri tek olay olarak
+// döner; ESC'den sonra tamponda veri yoksa tuş Esc kabul edilir.
+func readKey(reader *bufio.Reader) (keyEvent, error) {
+	b, err := reader.ReadByte()
+	if err != nil {
+		return keyEvent{}, err
+	}
+
+	switch b {
+	case '\r', '\n':
+		return keyEvent{key: keyEnter}, nil
+	case '\t':
+		return keyEvent{key: keyTab}, nil
+	case 3:
+		return keyEvent{key: keyCtrlC}, nil
+	case 0x1b:
+		if reader.Buffered() == 0 {
+			return keyEvent{key: keyEsc}, nil
+		}
+		return readEscape(reader)
+	}
+
+	if b < utf8.RuneSelf {
+		if b < 0x20 || b == 0x7f {
+			return keyEvent{key: keyUnknown}, nil
+		}
+		return keyEvent{key: keyRune, r: rune(b)}, nil
+	}
+	// Çok baytlı UTF-8 karakter (ör. Türkçe harfler)
+	if err := reader.UnreadByte(); err != nil {
+		return keyEvent{}, err
+	}
+	r, _, err := reader.ReadRune()
+	if err != nil {
+		return keyEvent{}, err
+	}
+	return keyEvent{key: keyRune, r: r}, nil
+}
+
+// readEscape "ESC [ A" veya "ESC [ 5 ~" gibi CSI/SS3 dizilerini çözer
+func readEscape(reader *bufio.Reader) (keyEvent, error) {
+	prefix, err := reader.ReadByte()
+	if err != nil {
+		return keyEvent{}, err
+	}
+	if prefix != '[' && prefix != 'O' {
+		return keyEvent{key: keyUnknown}, nil
+	}
+
+	var params []byte
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return keyEvent{}, err
+		}
+		if b >= 0x40 && b <= 0x7e {
+			return keyEvent{key: escapeKey(string(params), b)}, nil
+		}
+		params = append(params, b)
+	}
+}
+
+func escapeKey(params string, final byte) key {
+	switch final {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	case 'C':
+		return keyRight
+	case 'D':
+		return keyLeft
+	case 'H':
+		return keyHome
+	case 'F':
+		return keyEnd
+	case '~':
+		switch params {
+		case "1", "7":
+			return keyHome
+		case "4", "8":
+			return keyEnd
+		case "5":
+			return keyPageUp
+		case "6":
+			return keyPageDown
+		}
+	}
+	return keyUnknown
+}
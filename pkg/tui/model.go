@@ -0,0 +1,537 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/info"
+	"tamerGoClient/pkg/output"
+	"tamerGoClient/pkg/resource"
+	"tamerGoClient/pkg/session"
+	"tamerGoClient/pkg/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	styleReverse = "\x1b[7m"
+	styleBold    = "\x1b[1m"
+	styleReset   = "\x1b[0m"
+
+	sidebarWidth = 26  // En uzun tür adı (persistentvolumeclaims) ve işaretçi
+	logTailLines = 500 // Log görünümünde gösterilen son satır sayısı
+	chromeLines  = 4   // Başlık, tablo başlığı, kısayollar ve durum satırı
+	viewChrome   = 3   // Metin görünümünde başlık, kısayollar ve durum satırı
+)
+
+type mode int
+
+const (
+	modeList mode = iota
+	modeView
+	modeConfirmDelete
+)
+
+// textView detay, log ve yardım ekranlarında kaydırılabilir metin
+type textView struct {
+	title  string
+	lines  []string
+	offset int
+
+	pod       *corev1.Pod // Log görünümündeyse logları gösterilen pod
+	container int         // pod.Spec.Containers içindeki index
+}
+
+// model tam ekran arayüzün durumunu tutar. Terminalden bağımsızdır; Run tuşları
+// handle'a iletir ve render çıktısını ekrana basar.
+type model struct {
+	s *session.Session
+
+	kind    int // info.Kinds içindeki index
+	items   []runtime.Object
+	headers []string
+	rows    [][]string
+	cursor  int
+	offset  int
+
+	mode   mode
+	view   *textView
+	status string
+	height int // Son çizimdeki ekran yüksekliği, sayfa kaydırmada kullanılır
+
+	// editFile verilen dosyayı kullanıcının editöründe açar; Run terminali
+	// bu süre boyunca normal moda alır
+	editFile func(path string) error
+}
+
+func newModel(s *session.Session) *model {
+	m := &model{s: s, kind: initialKind(), height: 24, editFile: runEditor}
+	m.load()
+	return m
+}
+
+// initialKind açılışta pod listesini seçer
+func initialKind() int {
+	for i, kind := range info.Kinds {
+		if kind.Name == "pods" {
+			return i
+		}
+	}
+	return 0
+}
+
+func (m *model) currentKind() info.Kind {
+	return info.Kinds[m.kind]
+}
+
+// load seçili türün listesini yeniden alır; imleç mümkünse yerinde kalır
+func (m *model) load() {
+	kind := m.currentKind()
+	m.items, m.rows, m.headers = nil, nil, nil
+
+	list, err := info.Collect(m.s, kind.List, metav1.ListOptions{})
+	if err == nil {
+		m.items, err = meta.ExtractList(list)
+	}
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: %s listesi alınamadı: %v", kind.Name, err)
+		m.cursor, m.offset = 0, 0
+		return
+	}
+
+	// Namespace sütunu listeleme sonrası belirlenir; yetki yoksa oturum yedek namespace'e daralmış olabilir
+	columns := kind.Columns
+	if kind.Namespaced && m.s.Namespace == "" {
+		columns = append([]output.Column{output.NamespaceColumn}, columns...)
+	}
+	for _, column := range columns {
+		if !column.Wide {
+			m.headers = append(m.headers, i18n.T(column.Header))
+		}
+	}
+
+	for _, item := range m.items {
+		row := make([]string, 0, len(m.headers))
+		for _, column := range columns {
+			if !column.Wide {
+				row = append(row, output.Cell(column.Value(item)))
+			}
+		}
+		m.rows = append(m.rows, row)
+	}
+	m.cursor = clamp(m.cursor, 0, len(m.items)-1)
+	m.status = fmt.Sprintf("%d %s", len(m.items), kind.Name)
+}
+
+// selected imleçteki nesneyi döndürür
+func (m *model) selected() (runtime.Object, metav1.Object, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil, nil, false
+	}
+	accessor, err := meta.Accessor(m.items[m.cursor])
+	if err != nil {
+		return nil, nil, false
+	}
+	return m.items[m.cursor], accessor, true
+}
+
+// handle bir tuşu işler; arayüzden çıkılacaksa true döner
+func (m *model) handle(ev keyEvent) bool {
+	if ev.key == keyCtrlC {
+		return true
+	}
+	switch m.mode {
+	case modeView:
+		m.handleView(ev)
+	case modeConfirmDelete:
+		m.handleConfirm(ev)
+	default:
+		return m.handleList(ev)
+	}
+	return false
+}
+
+func (m *model) handleList(ev keyEvent) bool {
+	page := m.listHeight()
+	switch {
+	case ev.key == keyUp || ev.r == 'k':
+		m.cursor--
+	case ev.key == keyDown || ev.r == 'j':
+		m.cursor++
+	case ev.key == keyPageUp:
+		m.cursor -= page
+	case ev.key == keyPageDown:
+		m.cursor += page
+	case ev.key == keyHome || ev.r == 'g':
+		m.cursor = 0
+	case ev.key == keyEnd || ev.r == 'G':
+		m.cursor = len(m.items) - 1
+	case ev.key == keyLeft:
+		m.switchKind(-1)
+	case ev.key == keyRight || ev.key == keyTab:
+		m.switchKind(1)
+	case ev.key == keyEnter:
+		m.showDetail()
+	case ev.r == 'l':
+		m.showLogs()
+	case ev.r == 'd':
+		m.confirmDelete()
+	case ev.r == 'e':
+		m.edit()
+	case ev.r == 'r':
+		m.load()
+	case ev.r == '?':
+		m.showHelp()
+	case ev.r == 'q':
+		return true
+	}
+	m.cursor = clamp(m.cursor, 0, len(m.items)-1)
+	return false
+}
+
+func (m *model) switchKind(delta int) {
+	m.kind = (m.kind + delta + len(info.Kinds)) % len(info.Kinds)
+	m.cursor, m.offset = 0, 0
+	m.load()
+}
+
+func (m *model) handleView(ev keyEvent) {
+	v := m.view
+	page := m.viewHeight()
+	switch {
+	case ev.key == keyUp || ev.r == 'k':
+		v.offset--
+	case ev.key == keyDown || ev.r == 'j':
+		v.offset++
+	case ev.key == keyPageUp:
+		v.offset -= page
+	case ev.key == keyPageDown || ev.r == ' ':
+		v.offset += page
+	case ev.key == keyHome || ev.r == 'g':
+		v.offset = 0
+	case ev.key == keyEnd || ev.r == 'G':
+		v.offset = len(v.lines)
+	case v.pod != nil && ev.r == 'c':
+		v.container = (v.container + 1) % len(v.pod.Spec.Containers)
+		m.loadLogs()
+	case v.pod != nil && ev.r == 'r':
+		m.loadLogs()
+	case ev.key == keyEsc || ev.r == 'q' || ev.key == keyLeft:
+		m.mode, m.view = modeList, nil
+		return
+	}
+	v.offset = clamp(v.offset, 0, len(v.lines)-page)
+}
+
+func (m *model) handleConfirm(ev keyEvent) {
+	m.mode = modeList
+	if !i18n.IsYes(string(ev.r)) {
+		m.status = i18n.T("Silme iptal edildi.")
+		return
+	}
+
+	kind := m.currentKind()
+	_, obj, ok := m.selected()
+	if !ok {
+		return
+	}
+	if err := resource.Delete(m.s, kind.Singular(), obj.GetNamespace(), obj.GetName()); err != nil {
+		m.status = i18n.Sprintf("Hata: %s/%s silinemedi: %v", kind.Singular(), obj.GetName(), err)
+		return
+	}
+	m.load()
+	m.status = i18n.Sprintf("%s/%s silindi", kind.Singular(), obj.GetName())
+}
+
+func (m *model) showDetail() {
+	item, obj, ok := m.selected()
+	if !ok {
+		return
+	}
+	data, err := output.MarshalYAML(item)
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: %v", err)
+		return
+	}
+	m.openView(&textView{
+		title: fmt.Sprintf("%s/%s", m.currentKind().Singular(), obj.GetName()),
+		lines: strings.Split(strings.TrimRight(string(data), "\n"), "\n"),
+	})
+}
+
+func (m *model) showLogs() {
+	item, _, ok := m.selected()
+	pod, isPod := item.(*corev1.Pod)
+	if !ok || !isPod {
+		m.status = i18n.T("Loglar yalnızca pod listesinde görüntülenebilir.")
+		return
+	}
+	if len(pod.Spec.Containers) == 0 {
+		m.status = i18n.T("Pod'da container bulunamadı.")
+		return
+	}
+
+	view := &textView{pod: pod}
+	if name, err := info.DefaultContainer(pod); err == nil {
+		for i, container := range pod.Spec.Containers {
+			if container.Name == name {
+				view.container = i
+			}
+		}
+	}
+	m.openView(view)
+	m.loadLogs()
+}
+
+// loadLogs log görünümündeki container'ın son satırlarını alır ve sona kaydırır
+func (m *model) loadLogs() {
+	v := m.view
+	container := v.pod.Spec.Containers[v.container].Name
+	v.title = i18n.Sprintf("Loglar: %s/%s [%s]", v.pod.Namespace, v.pod.Name, container)
+
+	ctx, cancel := m.s.Context()
+	defer cancel()
+	var buf bytes.Buffer
+	opts := &corev1.PodLogOptions{Container: container, TailLines: utils.Int64(logTailLines)}
+	if err := info.StreamPodLogs(ctx, m.s, v.pod.Namespace, v.pod.Name, opts, &buf); err != nil {
+		v.lines = []string{i18n.Sprintf("Hata: Loglar alınamadı: %v", err)}
+	} else if buf.Len() == 0 {
+		v.lines = []string{i18n.T("(log yok)")}
+	} else {
+		v.lines = strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	}
+	v.offset = clamp(len(v.lines), 0, len(v.lines)-m.viewHeight())
+}
+
+func (m *model) showHelp() {
+	m.openView(&textView{title: i18n.T("Kısayollar"), lines: []string{
+		i18n.T("←/→, Tab        Kaynak türünü değiştir"),
+		i18n.T("↑/↓, j/k        Seçimi taşı"),
+		i18n.T("PgUp/PgDn       Sayfa sayfa ilerle"),
+		i18n.T("Home/End, g/G   Listenin başına/sonuna git"),
+		i18n.T("Enter           Seçili kaynağın detayını (YAML) göster"),
+		i18n.T("l               Pod loglarını göster (c: container değiştir, r: yenile)"),
+		i18n.T("d               Seçili kaynağı sil (pod, deployment, service)"),
+		i18n.T("e               Seçili kaynağı $EDITOR ile düzenle (pod, deployment, service)"),
+		i18n.T("r               Listeyi yenile"),
+		i18n.T("Esc, q          Görünümden çık (listede q arayüzü kapatır)"),
+	}})
+}
+
+func (m *model) openView(v *textView) {
+	m.mode, m.view = modeView, v
+}
+
+func (m *model) confirmDelete() {
+	kind := m.currentKind()
+	_, obj, ok := m.selected()
+	if !ok {
+		return
+	}
+	if !resource.Supports(kind.Singular()) {
+		m.status = i18n.Sprintf("%s silme desteklenmiyor.", kind.Name)
+		return
+	}
+	m.mode = modeConfirmDelete
+	m.status = i18n.Sprintf("%s/%s silinsin mi? (e/h)", kind.Singular(), obj.GetName())
+}
+
+// edit seçili kaynağı YAML olarak editörde açar ve değiştiyse günceller
+func (m *model) edit() {
+	kind := m.currentKind()
+	item, obj, ok := m.selected()
+	if !ok {
+		return
+	}
+	if !resource.Supports(kind.Singular()) {
+		m.status = i18n.Sprintf("%s düzenleme desteklenmiyor.", kind.Name)
+		return
+	}
+
+	original, err := output.MarshalYAML(item)
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: %v", err)
+		return
+	}
+	file, err := os.CreateTemp("", obj.GetName()+"-*.yaml")
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: Geçici dosya oluşturulamadı: %v", err)
+		return
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(original)
+	file.Close()
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: Geçici dosya yazılamadı: %v", err)
+		return
+	}
+
+	if err := m.editFile(file.Name()); err != nil {
+		m.status = i18n.Sprintf("Hata: Editör çalıştırılamadı: %v", err)
+		return
+	}
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: Düzenlenen dosya okunamadı: %v", err)
+		return
+	}
+	if bytes.Equal(edited, original) {
+		m.status = i18n.T("Değişiklik yapılmadı.")
+		return
+	}
+
+	updated, err := resource.UpdateFromYAML(m.s, string(edited))
+	if err != nil {
+		m.status = i18n.Sprintf("Hata: %s güncellenemedi: %v", obj.GetName(), err)
+		return
+	}
+	m.load()
+	m.status = i18n.Sprintf("%s güncellendi", updated)
+}
+
+// listHeight tabloda aynı anda gösterilebilen satır sayısı
+func (m *model) listHeight() int {
+	return max(m.height-chromeLines, 1)
+}
+
+func (m *model) viewHeight() int {
+	return max(m.height-viewChrome, 1)
+}
+
+// render ekranın tamamını satır satır döndürür; her satır tam olarak width
+// karakter genişliğindedir
+func (m *model) render(width, height int) []string {
+	m.height = height
+	lines := make([]string, 0, height)
+
+	if m.mode == modeView {
+		v := m.view
+		lines = append(lines, styleReverse+fit(" "+v.title, width)+styleReset)
+		body := m.viewHeight()
+		start := clamp(v.offset, 0, len(v.lines)-body)
+		for i := 0; i < body; i++ {
+			line := ""
+			if start+i < len(v.lines) {
+				line = v.lines[start+i]
+			}
+			lines = append(lines, fit(line, width))
+		}
+		hints := i18n.T(" ↑/↓ kaydır  PgUp/PgDn sayfa  Esc geri")
+		if v.pod != nil {
+			hints += i18n.T("  c container  r yenile")
+		}
+		lines = append(lines, fit(hints, width))
+		return append(lines, m.statusLine(width))
+	}
+
+	kind := m.currentKind()
+	title := fmt.Sprintf(" tamerGoClient │ %s (%d)", kind.Name, len(m.items))
+	lines = append(lines, styleReverse+fit(title, width)+styleReset)
+
+	// İmleç görünür kalacak şekilde tablo kaydırılır
+	body := m.listHeight()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+body {
+		m.offset = m.cursor - body + 1
+	}
+
+	tableWidth := max(width-sidebarWidth-1, 0)
+	widths := columnWidths(m.headers, m.rows)
+	for i := 0; i < body+1; i++ {
+		sidebar := ""
+		if i < len(info.Kinds) {
+			sidebar = fit("  "+info.Kinds[i].Name, sidebarWidth)
+			if i == m.kind {
+				sidebar = styleReverse + fit("▶ "+info.Kinds[i].Name, sidebarWidth) + styleReset
+			}
+		} else {
+			sidebar = fit("", sidebarWidth)
+		}
+
+		var cell string
+		switch row := m.offset + i - 1; {
+		case i == 0:
+			cell = styleBold + fit(formatRow(m.headers, widths), tableWidth) + styleReset
+		case row < len(m.rows) && row == m.cursor:
+			cell = styleReverse + fit(formatRow(m.rows[row], widths), tableWidth) + styleReset
+		case row < len(m.rows):
+			cell = fit(formatRow(m.rows[row], widths), tableWidth)
+		case i == 1 && len(m.rows) == 0:
+			cell = fit(i18n.T("Kaynak bulunamadı."), tableWidth)
+		default:
+			cell = fit("", tableWidth)
+		}
+		lines = append(lines, sidebar+"│"+cell)
+	}
+
+	lines = append(lines, fit(i18n.T(" ←/→ tür  ↑/↓ seç  Enter detay  l log  d sil  e düzenle  r yenile  ? yardım  q çık"), width))
+	return append(lines, m.statusLine(width))
+}
+
+// statusLine aktif bağlantıyı, namespace'i, filtreleri ve son mesajı gösterir
+func (m *model) statusLine(width int) string {
+	name := m.s.Name
+	if name == "" {
+		name = "-"
+	}
+	text := fmt.Sprintf(" %s │ Namespace: %s │ ", name, m.s.NamespaceLabel())
+	if !m.s.Settings.Filter.IsEmpty() {
+		text += i18n.Sprintf("Filtre: %s │ ", m.s.Settings.Filter)
+	}
+	text += m.status
+	return styleReverse + fit(text, width) + styleReset
+}
+
+func columnWidths(headers []string, rows [][]string) []int {
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = utf8.RuneCountInString(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	return widths
+}
+
+func formatRow(cells []string, widths []int) string {
+	padded := make([]string, len(cells))
+	for i, cell := range cells {
+		padded[i] = fit(cell, widths[i])
+	}
+	return " " + strings.Join(padded, "   ")
+}
+
+// fit metni tam olarak width karaktere tamamlar veya "…" ile keser
+func fit(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	text = strings.ReplaceAll(text, "\t", "    ")
+	count := utf8.RuneCountInString(text)
+	if count <= width {
+		return text + strings.Repeat(" ", width-count)
+	}
+	runes := []rune(text)
+	return string(runes[:width-1]) + "…"
+}
+
+// clamp value'yu [low, high] aralığına sınırlar; high < low ise low döner
+func clamp(value, low, high int) int {
+	if value > high {
+		value = high
+	}
+	if value < low {
+		value = low
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"tamerGoClient/pkg/i18n"
+	"tamerGoClient/pkg/session"
+
+	"golang.org/x/term"
+)
+
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l" // Alternatif ekran, imleç gizli
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+// Run oturum için tam ekran, klavye ile kullanılan arayüzü açar ve kullanıcı
+// çıkana kadar bekler. Standart giriş ve çıkış bir terminal olmalıdır.
+func Run(s *session.Session) error {
+	if s == nil {
+		return errors.New(i18n.T("önce bir Kubernetes cluster'ına bağlanmalısınız"))
+	}
+	in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !term.IsTerminal(in) || !term.IsTerminal(out) {
+		return errors.New(i18n.T("tam ekran arayüz için bir terminal gerekli"))
+	}
+
+	state, err := term.MakeRaw(in)
+	if err != nil {
+		return i18n.Errorf("terminal raw moda alınamadı: %v", err)
+	}
+	fmt.Print(enterScreen)
+	restore := func() {
+		fmt.Print(leaveScreen)
+		term.Restore(in, state)
+	}
+	defer restore()
+
+	m := newModel(s)
+	// Editör çalışırken terminal normal moda döner, ardından arayüz geri yüklenir
+	m.editFile = func(path string) error {
+		restore()
+		defer func() {
+			state, _ = term.MakeRaw(in)
+			fmt.Print(enterScreen)
+		}()
+		return runEditor(path)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		width, height, err := term.GetSize(out)
+		if err != nil {
+			width, height = 80, 24
+		}
+		draw(os.Stdout, m.render(width, height))
+
+		ev, err := readKey(reader)
+		if err != nil {
+			return err
+		}
+		if m.handle(ev) {
+			return nil
+		}
+	}
+}
+
+// draw ekranı imleci başa alarak yeniden çizer; satırlar ekran genişliğinde
+// olduğundan önceki içerik tamamen ezilir
+func draw(w io.Writer, lines []string) {
+	fmt.Fprint(w, "\x1b[H"+strings.Join(lines, "\r\n"))
+}
+
+// runEditor dosyayı $EDITOR (yoksa vi veya notepad) ile açar
+func runEditor(path string) error {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+		if runtime.GOOS == "windows" {
+			editor = []string{"notepad"}
+		}
+	}
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
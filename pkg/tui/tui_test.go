@@ -0,0 +1,269 @@
+package tui
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"tamerGoClient/internal/testutil"
+	"tamerGoClient/pkg/info"
+	"tamerGoClient/pkg/session"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+var ansi = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+func newTestModel(objects ...runtime.Object) *model {
+	s := &session.Session{Name: "test-cluster", Client: fake.NewClientset(objects...)}
+	return newModel(s)
+}
+
+// screen modeli 100x20 boyutunda çizer ve stil kodları olmadan döndürür
+func screen(t *testing.T, m *model) string {
+	t.Helper()
+	lines := m.render(100, 20)
+	if len(lines) != 20 {
+		t.Fatalf("20 satır bekleniyordu, %d çizildi", len(lines))
+	}
+	for i, line := range lines {
+		line = ansi.ReplaceAllString(line, "")
+		if width := utf8.RuneCountInString(line); width != 100 {
+			t.Errorf("%d. satır genişliği %d, 100 bekleniyordu: %q", i, width, line)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+func press(m *model, keys ...interface{}) {
+	for _, k := range keys {
+		switch k := k.(type) {
+		case key:
+			m.handle(keyEvent{key: k})
+		case rune:
+			m.handle(keyEvent{key: keyRune, r: k})
+		}
+	}
+}
+
+func pod(namespace, name string, containers ...string) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, container := range containers {
+		p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: container, Image: "nginx:1.25"})
+	}
+	return p
+}
+
+func TestReadKey(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader("\x1b[A\x1b[B\x1b[6~\x1bOHqş\r\t\x03"))
+	want := []keyEvent{
+		{key: keyUp}, {key: keyDown}, {key: keyPageDown}, {key: keyHome},
+		{key: keyRune, r: 'q'}, {key: keyRune, r: 'ş'}, {key: keyEnter}, {key: keyTab}, {key: keyCtrlC},
+	}
+	for i, expected := range want {
+		ev, err := readKey(reader)
+		if err != nil {
+			t.Fatalf("%d. tuş: %v", i, err)
+		}
+		if ev != expected {
+			t.Errorf("%d. tuş = %+v, %+v bekleniyordu", i, ev, expected)
+		}
+	}
+
+	// Arkasından veri gelmeyen ESC tek başına Esc tuşudur
+	if ev, _ := readKey(bufio.NewReader(strings.NewReader("\x1b"))); ev.key != keyEsc {
+		t.Errorf("Esc bekleniyordu: %+v", ev)
+	}
+}
+
+func TestRenderListAndStatusBar(t *testing.T) {
+	m := newTestModel(pod("default", "web", "app"), pod("client-access", "worker", "app"))
+
+	out := screen(t, m)
+	for _, want := range []string{"▶ pods", "NAMESPACE", "web", "worker", "test-cluster", "Namespace: tümü", "2 pods"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ekranda %q bulunamadı:\n%s", want, out)
+		}
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	m := newTestModel(
+		pod("default", "a", "app"), pod("default", "b", "app"),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}},
+	)
+
+	press(m, keyDown, keyDown, keyDown)
+	if m.cursor != 1 {
+		t.Errorf("imleç son satırda kalmalıydı: %d", m.cursor)
+	}
+	press(m, keyUp, keyUp)
+	if m.cursor != 0 {
+		t.Errorf("imleç ilk satırda kalmalıydı: %d", m.cursor)
+	}
+
+	press(m, keyRight)
+	if m.currentKind().Name != "deployments" {
+		t.Fatalf("deployments'a geçilmeliydi: %s", m.currentKind().Name)
+	}
+	if out := screen(t, m); !strings.Contains(out, "api") || !strings.Contains(out, "▶ deployments") {
+		t.Errorf("deployment listesi gösterilmeli:\n%s", out)
+	}
+
+	// İlk türden sola gidildiğinde son türe geçilir
+	m.kind = 0
+	press(m, keyLeft)
+	if m.kind != len(info.Kinds)-1 {
+		t.Errorf("son türe geçilmeliydi: %d", m.kind)
+	}
+}
+
+func TestScrollKeepsCursorVisible(t *testing.T) {
+	var objects []runtime.Object
+	for i := 0; i < 40; i++ {
+		objects = append(objects, pod("default", "pod-"+string(rune('a'+i%26))+string(rune('a'+i/26)), "app"))
+	}
+	m := newTestModel(objects...)
+	screen(t, m)
+
+	press(m, keyEnd)
+	out := screen(t, m)
+	if !strings.Contains(out, "pod-nb") {
+		t.Errorf("son pod görünür olmalı:\n%s", out)
+	}
+	if strings.Contains(out, "pod-aa") {
+		t.Errorf("ilk pod kaydırılıp gizlenmeliydi:\n%s", out)
+	}
+}
+
+func TestDetailView(t *testing.T) {
+	m := newTestModel(pod("default", "web", "app"))
+
+	press(m, keyEnter)
+	if m.mode != modeView {
+		t.Fatal("detay görünümü açılmalıydı")
+	}
+	out := screen(t, m)
+	for _, want := range []string{"pod/web", "kind: Pod", "image: nginx:1.25"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detayda %q bulunamadı:\n%s", want, out)
+		}
+	}
+
+	press(m, keyEsc)
+	if m.mode != modeList {
+		t.Error("Esc listeye dönmeliydi")
+	}
+}
+
+func TestLogsView(t *testing.T) {
+	m := newTestModel(pod("default", "web", "app", "sidecar"))
+
+	press(m, 'l')
+	out := screen(t, m)
+	if !strings.Contains(out, "fake logs") || !strings.Contains(out, "[app]") {
+		t.Errorf("ilk container'ın logları gösterilmeli:\n%s", out)
+	}
+	press(m, 'c')
+	if out := screen(t, m); !strings.Contains(out, "[sidecar]") {
+		t.Errorf("c ile sonraki container'a geçilmeli:\n%s", out)
+	}
+
+	press(m, 'q', keyRight, 'l')
+	if m.mode != modeList || !strings.Contains(m.status, "yalnızca pod") {
+		t.Errorf("pod olmayan türde log açılmamalı: %q", m.status)
+	}
+}
+
+func TestDeleteWithConfirmation(t *testing.T) {
+	m := newTestModel(pod("default", "a", "app"), pod("default", "b", "app"))
+	ctx := context.Background()
+
+	press(m, 'd', 'h')
+	if !strings.Contains(m.status, "iptal") {
+		t.Errorf("silme iptal edilmeliydi: %q", m.status)
+	}
+
+	press(m, keyDown, 'd')
+	if out := screen(t, m); !strings.Contains(out, "pod/b silinsin mi? (e/h)") {
+		t.Errorf("onay sorusu gösterilmeli:\n%s", out)
+	}
+	press(m, 'e')
+	if _, err := m.s.Client.CoreV1().Pods("default").Get(ctx, "b", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("pod/b silinmeliydi, alınan: %v", err)
+	}
+	if len(m.items) != 1 || m.status != "pod/b silindi" {
+		t.Errorf("liste yenilenmeli (%d): %q", len(m.items), m.status)
+	}
+
+	// Desteklenmeyen türde onay sorulmaz
+	m.kind = 0
+	m.load()
+	press(m, 'd')
+	if m.mode != modeList {
+		t.Error("namespace silme için onay sorulmamalıydı")
+	}
+}
+
+func TestEdit(t *testing.T) {
+	m := newTestModel(pod("default", "web", "app"))
+	m.editFile = func(path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(strings.Replace(string(data), "nginx:1.25", "nginx:1.27", 1)), 0o600)
+	}
+
+	press(m, 'e')
+	if m.status != "pod/web güncellendi" {
+		t.Fatalf("güncelleme mesajı bekleniyordu: %q", m.status)
+	}
+	updated, err := m.s.Client.CoreV1().Pods("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image := updated.Spec.Containers[0].Image; image != "nginx:1.27" {
+		t.Errorf("image güncellenmeliydi: %s", image)
+	}
+
+	m.editFile = func(string) error { return nil }
+	press(m, 'e')
+	if m.status != "Değişiklik yapılmadı." {
+		t.Errorf("değişiklik olmadığı bildirilmeli: %q", m.status)
+	}
+}
+
+func TestFit(t *testing.T) {
+	for _, tc := range []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"İSİM", 6, "İSİM  "},
+		{"çok-uzun-isim", 5, "çok-…"},
+		{"a\tb", 6, "a    b"},
+		{"x", 0, ""},
+	} {
+		if got := fit(tc.text, tc.width); got != tc.want {
+			t.Errorf("fit(%q, %d) = %q, %q beklendi", tc.text, tc.width, got, tc.want)
+		}
+	}
+}
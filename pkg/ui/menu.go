@@ -7,18 +7,35 @@ import (
 	"strconv"
 	"strings"
 	"tamerGoClient/pkg/auth"
+	"tamerGoClient/pkg/i18n"
 )
 
 func ShowMainMenu() int {
-	fmt.Println("\n=== Kubernetes Yönetim Arayüzü ===")
+	fmt.Println(i18n.T("\n=== Kubernetes Yönetim Arayüzü ==="))
+	if auth.GetActiveProfile() != "" {
+		i18n.Printf("(Aktif Profil: %s)\n", auth.GetActiveProfile())
+	}
 	if auth.GetActiveConnection() != "" {
-		fmt.Printf("(Aktif Bağlantı: %s)\n", auth.GetActiveConnection())
+		i18n.Printf("(Aktif Bağlantı: %s)\n", auth.GetActiveConnection())
+	}
+	if namespace := auth.GetNamespace(); namespace != "" {
+		fmt.Printf("(Namespace: %s)\n", namespace)
+	}
+	if impersonation := auth.GetImpersonation(); impersonation != "" {
+		i18n.Printf("(Kimliğine Bürünülen: %s)\n", impersonation)
+	}
+	if warning := auth.TokenExpiryWarning(); warning != "" {
+		i18n.Printf("Uyarı: %s\n", warning)
+	}
+	if warning := auth.InsecureWarning(); warning != "" {
+		i18n.Printf("UYARI: %s\n", warning)
 	}
-	fmt.Println("1. Kimlik Doğrulama")
-	fmt.Println("2. Cluster Bilgileri")
-	fmt.Println("3. Instance Oluştur")
-	fmt.Println("4. Çıkış")
-	fmt.Print("Seçiminiz (1-4): ")
+	fmt.Println(i18n.T("1. Kimlik Doğrulama"))
+	fmt.Println(i18n.T("2. Cluster Bilgileri"))
+	fmt.Println(i18n.T("3. Instance Oluştur"))
+	fmt.Println(i18n.T("4. Tam Ekran Arayüz"))
+	fmt.Println(i18n.T("5. Çıkış"))
+	fmt.Print(i18n.T("Seçiminiz (1-5): "))
 
 	reader := bufio.NewReader(os.Stdin)
 	input, _ := reader.ReadString('\n')